@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/presenter"
+	"password-validator/adapter/response"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+	"strings"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/utils"
+)
+
+type ComplianceReportController struct {
+	complianceReportUseCase usecase.ComplianceReportUseCase
+}
+
+func NewComplianceReportController(
+	complianceReportUseCase usecase.ComplianceReportUseCase,
+) ComplianceReportController {
+	return ComplianceReportController{
+		complianceReportUseCase: complianceReportUseCase,
+	}
+}
+
+// Execute reports the compliance of the policy named by the "name" path value, as
+// Markdown when the "format" query parameter or the Accept header asks for it.
+func (c ComplianceReportController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("ComplianceReportController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "policy-compliance-span")
+	defer span.End()
+
+	i := input.PolicyInput{Name: r.PathValue("name")}
+	span.SetAttributes(utils.StringAttribute("policy", i.Name))
+
+	output, err := c.complianceReportUseCase.Execute(newCtx, i)
+	if err != nil {
+		span.SetStatus(codes.Error, "ComplianceReportController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished ComplianceReportController execution")
+	span.SetStatus(codes.Ok, "ComplianceReportController execution finished with success")
+	if r.URL.Query().Get("format") == "markdown" || strings.Contains(r.Header.Get("Accept"), "text/markdown") {
+		response.NewMarkdown(presenter.ComplianceMarkdown(output), http.StatusOK).Send(w)
+		return
+	}
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
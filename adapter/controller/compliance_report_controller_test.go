@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/adapter/response"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ComplianceReportUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *ComplianceReportUseCaseMock) Execute(ctx context.Context, i input.PolicyInput) (output.ComplianceOutput, error) {
+	ret := c.Called(ctx, i)
+	return ret.Get(0).(output.ComplianceOutput), ret.Error(1)
+}
+
+func TestComplianceReportController(t *testing.T) {
+	tt := []struct {
+		name                string
+		url                 string
+		accept              string
+		usecaseError        error
+		expectedStatus      int
+		expectedContentType string
+	}{
+		{
+			name:                "json report",
+			url:                 "/password/policies/default/compliance",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+		},
+		{
+			name:                "markdown from the format parameter",
+			url:                 "/password/policies/default/compliance?format=markdown",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: response.MarkdownContentType,
+		},
+		{
+			name:                "markdown from the accept header",
+			url:                 "/password/policies/default/compliance",
+			accept:              "text/markdown",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: response.MarkdownContentType,
+		},
+		{
+			name:                "policy not found",
+			url:                 "/password/policies/default/compliance",
+			usecaseError:        _errors.NotFoundError{Entity: "Policy", ID: "default"},
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: "application/problem+json",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, test.url, nil)
+			req.SetPathValue("name", "default")
+			req.Header.Set("Accept", test.accept)
+			uc := &ComplianceReportUseCaseMock{}
+			uc.On("Execute", mock.Anything, input.PolicyInput{Name: "default"}).Return(output.ComplianceOutput{Policy: "default"}, test.usecaseError)
+			c := NewComplianceReportController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, test.expectedStatus, w.Result().StatusCode)
+			assert.Equal(t, test.expectedContentType, w.Result().Header.Get("Content-Type"))
+			uc.AssertExpectations(t)
+		})
+	}
+}
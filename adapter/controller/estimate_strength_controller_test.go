@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/core/usecase/output"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEstimateStrengthController(t *testing.T) {
+	tt := []struct {
+		name               string
+		usecaseOutput      output.StrengthOutput
+		stringBody         string
+		expectedReadAllErr bool
+		usecaseError       error
+		expectedStatus     int
+	}{
+		{
+			name:           "strength estimated",
+			stringBody:     `{"password":"AbTp9!fok"}`,
+			usecaseOutput:  output.StrengthOutput{Score: 2, Entropy: 53.59, Label: "fair"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:               "reading request body error",
+			expectedReadAllErr: true,
+			expectedStatus:     http.StatusBadRequest,
+		},
+		{
+			name:           "unmarshal error",
+			stringBody:     `error`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "usecase error",
+			stringBody:     `{"password":"AbTp9!fok"}`,
+			usecaseError:   errors.New("test"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			var body io.ReadCloser = io.NopCloser(strings.NewReader(test.stringBody))
+			if test.expectedReadAllErr {
+				body = ErrReader(0)
+			}
+			req := &http.Request{
+				Header: http.Header{},
+				Body:   body,
+			}
+			uc := &EstimateStrengthUseCaseMock{}
+			uc.On("Execute", mock.Anything, mock.Anything).Return(test.usecaseOutput, test.usecaseError)
+			c := NewEstimateStrengthController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+		})
+	}
+}
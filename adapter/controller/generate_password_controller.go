@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+)
+
+type GeneratePasswordController struct {
+	generatePasswordUseCase usecase.GeneratePasswordUseCase
+}
+
+func NewGeneratePasswordController(
+	generatePasswordUseCase usecase.GeneratePasswordUseCase,
+) GeneratePasswordController {
+	return GeneratePasswordController{
+		generatePasswordUseCase: generatePasswordUseCase,
+	}
+}
+
+func (c GeneratePasswordController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("GeneratePasswordController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "password-generate-span")
+	defer span.End()
+
+	jsonBody, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		log.Error("Error reading request body", err)
+		span.SetStatus(codes.Error, "GeneratePasswordController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, readError(err), nil)
+		return
+	}
+
+	var i input.GenerateInput
+	if len(jsonBody) > 0 {
+		if err := json.Unmarshal(jsonBody, &i); err != nil {
+			log.Error("error unmarshal generate input", err)
+			handler.HandleErrors(w, r, _errors.MalformedRequestError{Cause: err}, nil)
+			return
+		}
+	}
+
+	output, err := c.generatePasswordUseCase.Execute(newCtx, i)
+	if err != nil {
+		span.SetStatus(codes.Error, "GeneratePasswordController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished GeneratePasswordController execution")
+	span.SetStatus(codes.Ok, "GeneratePasswordController execution finished with success")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GeneratePasswordUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *GeneratePasswordUseCaseMock) Execute(ctx context.Context, i input.GenerateInput) (output.GenerateOutput, error) {
+	ret := c.Called(ctx, i)
+	return ret.Get(0).(output.GenerateOutput), ret.Error(1)
+}
+
+func TestGeneratePasswordController(t *testing.T) {
+	tt := []struct {
+		name               string
+		usecaseOutput      output.GenerateOutput
+		stringBody         string
+		expectedReadAllErr bool
+		usecaseError       error
+		expectedStatus     int
+	}{
+		{
+			name:           "password generated",
+			stringBody:     `{"length":12}`,
+			usecaseOutput:  output.GenerateOutput{Password: "Xk3!mP9@qR2#", Policy: "default"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "empty body uses defaults",
+			stringBody:     ``,
+			usecaseOutput:  output.GenerateOutput{Password: "Xk3!mP9@qR2#vB7$", Policy: "default"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "policy not found",
+			stringBody:     `{"policy":"missing"}`,
+			usecaseError:   _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid length",
+			stringBody:     `{"length":4}`,
+			usecaseError:   _errors.InvalidField{Field: "length", AsIs: "Must be between 9 and 128"},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "reading request body error",
+			expectedReadAllErr: true,
+			expectedStatus:     http.StatusBadRequest,
+		},
+		{
+			name:           "unmarshal error",
+			stringBody:     `error`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			var body io.ReadCloser = io.NopCloser(strings.NewReader(test.stringBody))
+			if test.expectedReadAllErr {
+				body = ErrReader(0)
+			}
+			req := &http.Request{
+				Header: http.Header{},
+				Body:   body,
+			}
+			uc := &GeneratePasswordUseCaseMock{}
+			uc.On("Execute", mock.Anything, mock.Anything).Return(test.usecaseOutput, test.usecaseError)
+			c := NewGeneratePasswordController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+		})
+	}
+}
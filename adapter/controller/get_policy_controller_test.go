@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetPolicyUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *GetPolicyUseCaseMock) Execute(ctx context.Context, i input.PolicyInput) (output.PolicyOutput, error) {
+	ret := c.Called(ctx, i)
+	return ret.Get(0).(output.PolicyOutput), ret.Error(1)
+}
+
+func TestGetPolicyController(t *testing.T) {
+	tt := []struct {
+		name           string
+		policy         string
+		usecaseOutput  output.PolicyOutput
+		usecaseError   error
+		expectedStatus int
+	}{
+		{
+			name:           "policy found",
+			policy:         "default",
+			usecaseOutput:  output.PolicyOutput{Name: "default", Default: true},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "policy not found",
+			policy:         "missing",
+			usecaseError:   _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/password/policies/"+test.policy, nil)
+			req.SetPathValue("name", test.policy)
+			uc := &GetPolicyUseCaseMock{}
+			uc.On("Execute", mock.Anything, input.PolicyInput{Name: test.policy}).Return(test.usecaseOutput, test.usecaseError)
+			c := NewGetPolicyController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+			uc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/utils"
+)
+
+type GetPolicyVersionController struct {
+	getPolicyVersionUseCase usecase.GetPolicyVersionUseCase
+}
+
+func NewGetPolicyVersionController(
+	getPolicyVersionUseCase usecase.GetPolicyVersionUseCase,
+) GetPolicyVersionController {
+	return GetPolicyVersionController{
+		getPolicyVersionUseCase: getPolicyVersionUseCase,
+	}
+}
+
+// Execute describes the policy named by the "name" path value as it was at the
+// "version" path value.
+func (c GetPolicyVersionController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("GetPolicyVersionController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "policy-version-span")
+	defer span.End()
+
+	i := input.PolicyVersionInput{Name: r.PathValue("name"), Version: r.PathValue("version")}
+	span.SetAttributes(utils.StringAttribute("policy", i.Name), utils.StringAttribute("version", i.Version))
+
+	output, err := c.getPolicyVersionUseCase.Execute(newCtx, i)
+	if err != nil {
+		span.SetStatus(codes.Error, "GetPolicyVersionController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished GetPolicyVersionController execution")
+	span.SetStatus(codes.Ok, "GetPolicyVersionController execution finished with success")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
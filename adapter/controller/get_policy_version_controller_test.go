@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetPolicyVersionUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *GetPolicyVersionUseCaseMock) Execute(ctx context.Context, i input.PolicyVersionInput) (output.PolicyOutput, error) {
+	ret := c.Called(ctx, i)
+	return ret.Get(0).(output.PolicyOutput), ret.Error(1)
+}
+
+func TestGetPolicyVersionController(t *testing.T) {
+	tt := []struct {
+		name           string
+		in             input.PolicyVersionInput
+		usecaseOutput  output.PolicyOutput
+		usecaseError   error
+		expectedStatus int
+	}{
+		{
+			name:           "version found",
+			in:             input.PolicyVersionInput{Name: "default", Version: "fa38b7e8dac9"},
+			usecaseOutput:  output.PolicyOutput{Name: "default", Version: "fa38b7e8dac9"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "version not found",
+			in:             input.PolicyVersionInput{Name: "default", Version: "000000000000"},
+			usecaseError:   _errors.NotFoundError{Entity: "Policy", ID: "default@000000000000"},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/password/policies/"+test.in.Name+"/versions/"+test.in.Version, nil)
+			req.SetPathValue("name", test.in.Name)
+			req.SetPathValue("version", test.in.Version)
+			uc := &GetPolicyVersionUseCaseMock{}
+			uc.On("Execute", mock.Anything, test.in).Return(test.usecaseOutput, test.usecaseError)
+			c := NewGetPolicyVersionController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+			uc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	"password-validator/core/usecase"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+)
+
+type ListPoliciesController struct {
+	listPoliciesUseCase usecase.ListPoliciesUseCase
+}
+
+func NewListPoliciesController(
+	listPoliciesUseCase usecase.ListPoliciesUseCase,
+) ListPoliciesController {
+	return ListPoliciesController{
+		listPoliciesUseCase: listPoliciesUseCase,
+	}
+}
+
+func (c ListPoliciesController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("ListPoliciesController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "policy-list-span")
+	defer span.End()
+
+	output, err := c.listPoliciesUseCase.Execute(newCtx)
+	if err != nil {
+		span.SetStatus(codes.Error, "ListPoliciesController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished ListPoliciesController execution")
+	span.SetStatus(codes.Ok, "ListPoliciesController execution finished with success")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
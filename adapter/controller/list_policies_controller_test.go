@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ListPoliciesUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *ListPoliciesUseCaseMock) Execute(ctx context.Context) (output.PolicyListOutput, error) {
+	ret := c.Called(ctx)
+	return ret.Get(0).(output.PolicyListOutput), ret.Error(1)
+}
+
+func TestListPoliciesController(t *testing.T) {
+	tt := []struct {
+		name           string
+		usecaseOutput  output.PolicyListOutput
+		usecaseError   error
+		expectedStatus int
+	}{
+		{
+			name: "policies listed",
+			usecaseOutput: output.PolicyListOutput{
+				Default:  "default",
+				Policies: []output.PolicyOutput{{Name: "default", Default: true}},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "usecase error",
+			usecaseError:   errors.New("test"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/password/policies", nil)
+			uc := &ListPoliciesUseCaseMock{}
+			uc.On("Execute", mock.Anything).Return(test.usecaseOutput, test.usecaseError)
+			c := NewListPoliciesController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/utils"
+)
+
+type ListPolicyVersionsController struct {
+	listPolicyVersionsUseCase usecase.ListPolicyVersionsUseCase
+}
+
+func NewListPolicyVersionsController(
+	listPolicyVersionsUseCase usecase.ListPolicyVersionsUseCase,
+) ListPolicyVersionsController {
+	return ListPolicyVersionsController{
+		listPolicyVersionsUseCase: listPolicyVersionsUseCase,
+	}
+}
+
+// Execute lists the versions of the policy named by the "name" path value.
+func (c ListPolicyVersionsController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("ListPolicyVersionsController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "policy-versions-span")
+	defer span.End()
+
+	i := input.PolicyInput{Name: r.PathValue("name")}
+	span.SetAttributes(utils.StringAttribute("policy", i.Name))
+
+	output, err := c.listPolicyVersionsUseCase.Execute(newCtx, i)
+	if err != nil {
+		span.SetStatus(codes.Error, "ListPolicyVersionsController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished ListPolicyVersionsController execution")
+	span.SetStatus(codes.Ok, "ListPolicyVersionsController execution finished with success")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	"password-validator/core/usecase"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+)
+
+type OutdatedPasswordsController struct {
+	outdatedPasswordsUseCase usecase.OutdatedPasswordsUseCase
+}
+
+func NewOutdatedPasswordsController(
+	outdatedPasswordsUseCase usecase.OutdatedPasswordsUseCase,
+) OutdatedPasswordsController {
+	return OutdatedPasswordsController{
+		outdatedPasswordsUseCase: outdatedPasswordsUseCase,
+	}
+}
+
+func (c OutdatedPasswordsController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("OutdatedPasswordsController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "password-outdated-span")
+	defer span.End()
+
+	output, err := c.outdatedPasswordsUseCase.Execute(newCtx)
+	if err != nil {
+		span.SetStatus(codes.Error, "OutdatedPasswordsController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished OutdatedPasswordsController execution")
+	span.SetStatus(codes.Ok, "OutdatedPasswordsController execution finished with success")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type OutdatedPasswordsUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *OutdatedPasswordsUseCaseMock) Execute(ctx context.Context) (output.OutdatedPasswordsOutput, error) {
+	ret := c.Called(ctx)
+	return ret.Get(0).(output.OutdatedPasswordsOutput), ret.Error(1)
+}
+
+func TestOutdatedPasswordsController(t *testing.T) {
+	tt := []struct {
+		name           string
+		usecaseOutput  output.OutdatedPasswordsOutput
+		usecaseError   error
+		expectedStatus int
+	}{
+		{
+			name:           "report generated",
+			usecaseOutput:  output.OutdatedPasswordsOutput{Total: 2, Outdated: 1},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "usecase error",
+			usecaseError:   errors.New("test"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/password/reports/outdated", nil)
+			uc := &OutdatedPasswordsUseCaseMock{}
+			uc.On("Execute", mock.Anything).Return(test.usecaseOutput, test.usecaseError)
+			c := NewOutdatedPasswordsController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+const (
+	feedbackMaxMessageSize = 4 << 10
+	feedbackIdleTimeout    = 30 * time.Second
+	feedbackWriteTimeout   = 5 * time.Second
+)
+
+type (
+	PasswordFeedbackController struct {
+		evaluatePasswordUseCase usecase.EvaluatePasswordUseCase
+		estimateStrengthUseCase usecase.EstimateStrengthUseCase
+		upgrader                websocket.Upgrader
+		idleTimeout             time.Duration
+	}
+
+	passwordFeedbackInput struct {
+		Seq int `json:"seq"`
+		input.PasswordInput
+	}
+
+	passwordFeedbackOutput struct {
+		Seq int `json:"seq"`
+		output.PasswordOutput
+		Strength output.StrengthOutput `json:"strength"`
+		Error    string                `json:"error,omitempty"`
+	}
+)
+
+func NewPasswordFeedbackController(
+	evaluatePasswordUseCase usecase.EvaluatePasswordUseCase,
+	estimateStrengthUseCase usecase.EstimateStrengthUseCase,
+) PasswordFeedbackController {
+	return PasswordFeedbackController{
+		evaluatePasswordUseCase: evaluatePasswordUseCase,
+		estimateStrengthUseCase: estimateStrengthUseCase,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  feedbackMaxMessageSize,
+			WriteBufferSize: feedbackMaxMessageSize,
+		},
+		idleTimeout: feedbackIdleTimeout,
+	}
+}
+
+// Execute upgrades the request to a WebSocket and answers every password draft the
+// client sends with the rules it already satisfies, the ones it still breaks and its
+// strength. The session is closed when a draft exceeds feedbackMaxMessageSize or when
+// the client sends nothing for idleTimeout. Drafts are only evaluated: they are never
+// saved, logged or counted as validations.
+func (c PasswordFeedbackController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("PasswordFeedbackController controller initialized")
+
+	conn, err := c.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error("Error upgrading feedback connection", err)
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(feedbackMaxMessageSize)
+
+	messages := 0
+	for {
+		conn.SetReadDeadline(time.Now().Add(c.idleTimeout))
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			c.close(conn, err)
+			break
+		}
+		messages++
+
+		conn.SetWriteDeadline(time.Now().Add(feedbackWriteTimeout))
+		if err := conn.WriteJSON(c.feedback(r.Context(), data)); err != nil {
+			log.Error("Error writing feedback message", err)
+			break
+		}
+	}
+
+	log.WithFields(logger.Field{"messages": messages}).Info("PasswordFeedbackController controller finished")
+}
+
+func (c PasswordFeedbackController) feedback(ctx context.Context, data []byte) passwordFeedbackOutput {
+	var i passwordFeedbackInput
+	if err := json.Unmarshal(data, &i); err != nil {
+		return passwordFeedbackOutput{Error: fmt.Sprintf("invalid JSON: %s", err.Error())}
+	}
+
+	result := passwordFeedbackOutput{Seq: i.Seq}
+	out, err := c.evaluatePasswordUseCase.Execute(ctx, i.PasswordInput)
+	result.PasswordOutput = out
+	if err != nil && !errors.As(err, &_errors.InvalidField{}) {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Strength, err = c.estimateStrengthUseCase.Execute(ctx, input.StrengthInput{Password: i.Password})
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// close tells the client the session ended for being idle. Oversized messages are
+// already answered by the websocket package with a CloseMessageTooBig frame.
+func (c PasswordFeedbackController) close(conn *websocket.Conn, err error) {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, "idle timeout"),
+			time.Now().Add(feedbackWriteTimeout))
+	}
+}
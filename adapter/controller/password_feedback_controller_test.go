@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/core/domain/tenant"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"password-validator/infrastructure/container"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type EstimateStrengthUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *EstimateStrengthUseCaseMock) Execute(ctx context.Context, i input.StrengthInput) (output.StrengthOutput, error) {
+	ret := c.Called(ctx, i)
+	return ret.Get(0).(output.StrengthOutput), ret.Error(1)
+}
+
+func dialFeedback(t *testing.T, c PasswordFeedbackController) *websocket.Conn {
+	server := httptest.NewServer(http.HandlerFunc(c.Execute))
+	t.Cleanup(server.Close)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func newFeedbackConn(t *testing.T, idleTimeout time.Duration) *websocket.Conn {
+	uc := &ValidatePasswordUseCaseMock{}
+	uc.On("Execute", mock.Anything, input.PasswordInput{Password: "AbTp9!fok"}).
+		Return(output.PasswordOutput{IsValid: true, Satisfied: []string{"min_length"}}, nil)
+	uc.On("Execute", mock.Anything, input.PasswordInput{Password: "Ab"}).
+		Return(output.PasswordOutput{Violations: []output.Violation{{Code: "min_length"}}}, _errors.InvalidField{Field: "password"})
+	uc.On("Execute", mock.Anything, input.PasswordInput{Password: "Ab", Policy: "missing"}).
+		Return(output.PasswordOutput{}, _errors.NotFoundError{Entity: "Policy", ID: "missing"})
+	strength := &EstimateStrengthUseCaseMock{}
+	strength.On("Execute", mock.Anything, mock.Anything).Return(output.StrengthOutput{Score: 2, Label: "fair"}, nil)
+	c := NewPasswordFeedbackController(uc, strength)
+	c.idleTimeout = idleTimeout
+	return dialFeedback(t, c)
+}
+
+func TestPasswordFeedbackController(t *testing.T) {
+	conn := newFeedbackConn(t, time.Second)
+	tt := []struct {
+		name     string
+		message  string
+		expected passwordFeedbackOutput
+	}{
+		{
+			name:    "valid draft",
+			message: `{"seq":1,"password":"AbTp9!fok"}`,
+			expected: passwordFeedbackOutput{
+				Seq:            1,
+				PasswordOutput: output.PasswordOutput{IsValid: true, Satisfied: []string{"min_length"}},
+				Strength:       output.StrengthOutput{Score: 2, Label: "fair"},
+			},
+		},
+		{
+			name:    "draft still breaking rules",
+			message: `{"seq":2,"password":"Ab"}`,
+			expected: passwordFeedbackOutput{
+				Seq:            2,
+				PasswordOutput: output.PasswordOutput{Violations: []output.Violation{{Code: "min_length"}}},
+				Strength:       output.StrengthOutput{Score: 2, Label: "fair"},
+			},
+		},
+		{
+			name:     "unknown policy",
+			message:  `{"seq":3,"password":"Ab","policy":"missing"}`,
+			expected: passwordFeedbackOutput{Seq: 3, Error: "Policy not found with ID 'missing'"},
+		},
+		{
+			name:     "malformed draft",
+			message:  `error`,
+			expected: passwordFeedbackOutput{Error: "invalid JSON: invalid character 'e' looking for beginning of value"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(test.message)))
+
+			var result passwordFeedbackOutput
+			require.NoError(t, conn.ReadJSON(&result))
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestPasswordFeedbackControllerLimits(t *testing.T) {
+	t.Run("oversized message", func(t *testing.T) {
+		conn := newFeedbackConn(t, time.Second)
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(strings.Repeat("a", feedbackMaxMessageSize+1))))
+
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig))
+	})
+
+	t.Run("idle timeout", func(t *testing.T) {
+		conn := newFeedbackConn(t, 50*time.Millisecond)
+
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway))
+	})
+}
+
+func TestPasswordFeedbackControllerDoesNotRecordDrafts(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+
+	c := container.New(time.Second, tenant.Registry{}, nil, nil)
+	conn := dialFeedback(t, NewPasswordFeedbackController(c.EvaluatePasswordUseCase, c.EstimateStrengthUseCase))
+	for _, draft := range []string{`{"seq":1,"password":"Ab"}`, `{"seq":2,"password":"AbTp9!fok"}`} {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(draft)))
+		var result passwordFeedbackOutput
+		require.NoError(t, conn.ReadJSON(&result))
+		assert.Empty(t, result.Error)
+	}
+
+	passwords, err := c.PasswordRepository.FindAll(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, passwords)
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			assert.NotEqual(t, "password.validations", m.Name)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	_errors "password-validator/core/errors"
+	"strings"
+)
+
+const (
+	jsonContentType = "application/json"
+	formContentType = "application/x-www-form-urlencoded"
+)
+
+// readError types a failed body read: PayloadTooLargeError when the body went past
+// the router size limit, MalformedRequestError otherwise.
+func readError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return _errors.PayloadTooLargeError{Limit: tooLarge.Limit, Cause: err}
+	}
+	return _errors.MalformedRequestError{Cause: err}
+}
+
+// mediaType returns the media type of the request body, which has to be one of
+// supported. Requests without a Content-Type are rejected too.
+func mediaType(r *http.Request, supported ...string) (string, error) {
+	header := r.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(header)
+	for _, s := range supported {
+		if err == nil && mediaType == s {
+			return mediaType, nil
+		}
+	}
+	return "", _errors.UnsupportedMediaTypeError{ContentType: header, Supported: supported, Cause: err}
+}
+
+// decodeJSON decodes a single JSON value into v, rejecting fields v does not have
+// and anything after the value. Errors name the offending field when there is one.
+func decodeJSON(body []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &typeErr):
+			return _errors.MalformedRequestError{Field: typeErr.Field, Cause: fmt.Errorf("field %q must be a %s", typeErr.Field, typeErr.Type)}
+		case strings.HasPrefix(err.Error(), "json: unknown field "):
+			field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+			return _errors.MalformedRequestError{Field: field, Cause: fmt.Errorf("unknown field %q", field)}
+		}
+		return _errors.MalformedRequestError{Cause: err}
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return _errors.MalformedRequestError{Cause: errors.New("unexpected data after the JSON value")}
+	}
+	return nil
+}
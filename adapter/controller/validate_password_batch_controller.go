@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+)
+
+type ValidatePasswordBatchController struct {
+	validatePasswordBatchUseCase usecase.ValidatePasswordBatchUseCase
+}
+
+func NewValidatePasswordBatchController(
+	validatePasswordBatchUseCase usecase.ValidatePasswordBatchUseCase,
+) ValidatePasswordBatchController {
+	return ValidatePasswordBatchController{
+		validatePasswordBatchUseCase: validatePasswordBatchUseCase,
+	}
+}
+
+func (c ValidatePasswordBatchController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("ValidatePasswordBatchController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "password-batch-span")
+	defer span.End()
+
+	jsonBody, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		log.Error("Error reading request body", err)
+		span.SetStatus(codes.Error, "ValidatePasswordBatchController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, readError(err), nil)
+		return
+	}
+
+	var i input.PasswordBatchInput
+	if err := json.Unmarshal(jsonBody, &i); err != nil {
+		log.Error("error unmarshal password batch input", err)
+		handler.HandleErrors(w, r, _errors.MalformedRequestError{Cause: err}, nil)
+		return
+	}
+
+	output, err := c.validatePasswordBatchUseCase.Execute(newCtx, i)
+	if err != nil {
+		span.SetStatus(codes.Error, "ValidatePasswordBatchController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	span.AddEvent("Finished ValidatePasswordBatchController execution")
+	span.SetStatus(codes.Ok, "ValidatePasswordBatchController execution finished with success")
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
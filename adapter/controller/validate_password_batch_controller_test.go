@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ValidatePasswordBatchUseCaseMock struct {
+	mock.Mock
+}
+
+func (c *ValidatePasswordBatchUseCaseMock) Execute(ctx context.Context, i input.PasswordBatchInput) (output.PasswordBatchOutput, error) {
+	ret := c.Called(ctx, i)
+	return ret.Get(0).(output.PasswordBatchOutput), ret.Error(1)
+}
+
+func TestValidatePasswordBatchController(t *testing.T) {
+	tt := []struct {
+		name               string
+		usecaseOutput      output.PasswordBatchOutput
+		stringBody         string
+		expectedReadAllErr bool
+		usecaseError       error
+		expectedStatus     int
+	}{
+		{
+			name:       "valid batch",
+			stringBody: `{"passwords":[{"password":"AbTp9!fok"},{"password":"AbTp9!foA"}]}`,
+			usecaseOutput: output.PasswordBatchOutput{Results: []output.PasswordBatchResult{
+				{PasswordOutput: output.PasswordOutput{IsValid: true}},
+				{PasswordOutput: output.PasswordOutput{IsValid: false}},
+			}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "batch too large",
+			stringBody:     `{"passwords":[]}`,
+			usecaseError:   _errors.InvalidField{Field: "passwords", AsIs: "Must have at most 1000 passwords"},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "reading request body error",
+			expectedReadAllErr: true,
+			expectedStatus:     http.StatusBadRequest,
+		},
+		{
+			name:           "unmarshal error",
+			stringBody:     `error`,
+			usecaseError:   errors.New("test"),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			var body io.ReadCloser = io.NopCloser(strings.NewReader(test.stringBody))
+			if test.expectedReadAllErr {
+				body = ErrReader(0)
+			}
+			req := &http.Request{
+				Header: http.Header{},
+				Body:   body,
+			}
+			uc := &ValidatePasswordBatchUseCaseMock{}
+			uc.On("Execute", mock.Anything, mock.Anything).Return(test.usecaseOutput, test.usecaseError)
+			c := NewValidatePasswordBatchController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+		})
+	}
+}
@@ -1,13 +1,17 @@
 package controller
 
 import (
-	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"password-validator/adapter/handler"
 	"password-validator/adapter/response"
+	_errors "password-validator/core/errors"
 	"password-validator/core/usecase"
 	"password-validator/core/usecase/input"
+	"sort"
 
 	"go.opentelemetry.io/otel/codes"
 
@@ -34,20 +38,27 @@ func (c ValidatePasswordController) Execute(w http.ResponseWriter, r *http.Reque
 	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "password-span")
 	defer span.End()
 
-	jsonBody, err := io.ReadAll(r.Body)
 	defer r.Body.Close()
+	contentType, err := mediaType(r, jsonContentType, formContentType)
+	if err != nil {
+		log.Error("Unsupported password input content type", err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.Error("Error reading request body", err)
 		span.SetStatus(codes.Error, "ValidatePasswordController Error")
 		span.RecordError(err)
-		response.NewError(err, http.StatusBadRequest, nil).Send(w)
+		handler.HandleErrors(w, r, readError(err), nil)
 		return
 	}
 
-	var i input.PasswordInput
-	if err := json.Unmarshal(jsonBody, &i); err != nil {
-		log.Error("error unmarshal password input", err)
-		handler.HandleErrors(w, err, nil)
+	i, err := decodePasswordInput(contentType, body)
+	if err != nil {
+		log.Error("error decoding password input", err)
+		handler.HandleErrors(w, r, err, nil)
 		return
 	}
 
@@ -57,7 +68,7 @@ func (c ValidatePasswordController) Execute(w http.ResponseWriter, r *http.Reque
 	if err != nil {
 		span.SetStatus(codes.Error, "ValidatePasswordController Error")
 		span.RecordError(err)
-		handler.HandleErrors(w, err, output)
+		handler.HandleErrors(w, r, err, output)
 		return
 	}
 
@@ -65,3 +76,48 @@ func (c ValidatePasswordController) Execute(w http.ResponseWriter, r *http.Reque
 	span.SetStatus(codes.Ok, "ValidatePasswordController execution finished with success")
 	response.NewSuccess(output, http.StatusOK).Send(w)
 }
+
+// passwordRequest is the body of a validation request. Password is a pointer so a
+// missing field is told apart from an empty password.
+type passwordRequest struct {
+	Password *string `json:"password"`
+	Policy   string  `json:"policy,omitempty"`
+}
+
+// decodePasswordInput reads a JSON or form body strictly: unknown and repeated
+// fields are rejected, and password is required.
+func decodePasswordInput(mediaType string, body []byte) (input.PasswordInput, error) {
+	var req passwordRequest
+	if mediaType == formContentType {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return input.PasswordInput{}, _errors.MalformedRequestError{Cause: err}
+		}
+		fields := make([]string, 0, len(values))
+		for field := range values {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			value := values[field]
+			if len(value) > 1 {
+				return input.PasswordInput{}, _errors.MalformedRequestError{Field: field, Cause: fmt.Errorf("field %q is repeated", field)}
+			}
+			switch field {
+			case "password":
+				req.Password = &value[0]
+			case "policy":
+				req.Policy = value[0]
+			default:
+				return input.PasswordInput{}, _errors.MalformedRequestError{Field: field, Cause: fmt.Errorf("unknown field %q", field)}
+			}
+		}
+	} else if err := decodeJSON(body, &req); err != nil {
+		return input.PasswordInput{}, err
+	}
+
+	if req.Password == nil {
+		return input.PasswordInput{}, _errors.MalformedRequestError{Field: "password", Cause: errors.New(`missing required field "password"`)}
+	}
+	return input.PasswordInput{Password: *req.Password, Policy: req.Policy}, nil
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
@@ -40,6 +41,7 @@ func TestValidatePasswordController(t *testing.T) {
 	tt := []struct {
 		name               string
 		usecaseOutput      output.PasswordOutput
+		contentType        string
 		stringBody         string
 		expectedReadAllErr bool
 		usecaseError       error
@@ -77,7 +79,7 @@ func TestValidatePasswordController(t *testing.T) {
 			stringBody:         `error`,
 			usecaseOutput:      output.PasswordOutput{},
 			usecaseError:       errors.New("test"),
-			expectedStatus:     http.StatusInternalServerError,
+			expectedStatus:     http.StatusBadRequest,
 		},
 	}
 
@@ -92,9 +94,12 @@ func TestValidatePasswordController(t *testing.T) {
 				body = io.NopCloser(stringReader)
 			}
 			req := &http.Request{
-				Header: http.Header{},
+				Header: http.Header{"Content-Type": {"application/json"}},
 				Body:   body,
 			}
+			if test.contentType != "" {
+				req.Header.Set("Content-Type", test.contentType)
+			}
 			uc := &ValidatePasswordUseCaseMock{}
 			uc.On("Execute", mock.Anything, mock.Anything).Return(test.usecaseOutput, test.usecaseError)
 			c := NewValidatePasswordController(uc)
@@ -105,3 +110,136 @@ func TestValidatePasswordController(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePasswordControllerDecoding(t *testing.T) {
+	tt := []struct {
+		name           string
+		contentType    string
+		body           string
+		expectedInput  input.PasswordInput
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "json with charset",
+			contentType:    "application/json; charset=utf-8",
+			body:           `{"password":"AbTp9!fok","policy":"passphrase"}`,
+			expectedInput:  input.PasswordInput{Password: "AbTp9!fok", Policy: "passphrase"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "empty password is still validated",
+			contentType:    "application/json",
+			body:           `{"password":""}`,
+			expectedInput:  input.PasswordInput{},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "form post",
+			contentType:    "application/x-www-form-urlencoded",
+			body:           "password=AbTp9%21fok&policy=passphrase",
+			expectedInput:  input.PasswordInput{Password: "AbTp9!fok", Policy: "passphrase"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown field",
+			contentType:    "application/json",
+			body:           `{"pass":"AbTp9!fok"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Malformed request. unknown field "pass".`,
+		},
+		{
+			name:           "missing password",
+			contentType:    "application/json",
+			body:           `{"policy":"passphrase"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Malformed request. missing required field "password".`,
+		},
+		{
+			name:           "null password",
+			contentType:    "application/json",
+			body:           `{"password":null}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Malformed request. missing required field "password".`,
+		},
+		{
+			name:           "wrong type",
+			contentType:    "application/json",
+			body:           `{"password":123456789}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Malformed request. field "password" must be a string.`,
+		},
+		{
+			name:           "data after the object",
+			contentType:    "application/json",
+			body:           `{"password":"AbTp9!fok"}{"password":"other"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Malformed request. unexpected data after the JSON value.",
+		},
+		{
+			name:           "unknown form field",
+			contentType:    "application/x-www-form-urlencoded",
+			body:           "pass=AbTp9%21fok",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Malformed request. unknown field "pass".`,
+		},
+		{
+			name:           "repeated form field",
+			contentType:    "application/x-www-form-urlencoded",
+			body:           "password=a&password=b",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Malformed request. field "password" is repeated.`,
+		},
+		{
+			name:           "unsupported content type",
+			contentType:    "text/plain",
+			body:           "AbTp9!fok",
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedError:  `Unsupported content type "text/plain". Expected application/json or application/x-www-form-urlencoded.`,
+		},
+		{
+			name:           "missing content type",
+			body:           `{"password":"AbTp9!fok"}`,
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedError:  `Unsupported content type "". Expected application/json or application/x-www-form-urlencoded.`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/password/validate", strings.NewReader(test.body))
+			if test.contentType != "" {
+				req.Header.Set("Content-Type", test.contentType)
+			}
+			uc := &ValidatePasswordUseCaseMock{}
+			uc.On("Execute", mock.Anything, test.expectedInput).Return(output.PasswordOutput{IsValid: true}, nil)
+			c := NewValidatePasswordController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, test.expectedStatus, w.Code)
+			if test.expectedError != "" {
+				var problem struct {
+					Detail string `json:"detail"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+				assert.Equal(t, test.expectedError, problem.Detail)
+				uc.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestValidatePasswordControllerBodyTooLarge(t *testing.T) {
+	w := httptest.NewRecorder()
+	body := io.NopCloser(strings.NewReader(`{"password":"AbTp9!fok"}`))
+	req := &http.Request{Header: http.Header{"Content-Type": {"application/json"}}, Body: http.MaxBytesReader(w, body, 8)}
+	uc := &ValidatePasswordUseCaseMock{}
+	c := NewValidatePasswordController(uc)
+
+	c.Execute(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Result().StatusCode)
+	uc.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"password-validator/adapter/handler"
+	"password-validator/adapter/response"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+)
+
+const (
+	streamMaxLineSize  = 64 << 10
+	streamReadTimeout  = 5 * time.Second
+	streamWriteTimeout = 15 * time.Second
+)
+
+type (
+	ValidatePasswordStreamController struct {
+		evaluatePasswordUseCase usecase.EvaluatePasswordUseCase
+	}
+
+	validatePasswordStreamLine struct {
+		Line int `json:"line"`
+		output.PasswordOutput
+		Error string `json:"error,omitempty"`
+	}
+)
+
+func NewValidatePasswordStreamController(
+	evaluatePasswordUseCase usecase.EvaluatePasswordUseCase,
+) ValidatePasswordStreamController {
+	return ValidatePasswordStreamController{
+		evaluatePasswordUseCase: evaluatePasswordUseCase,
+	}
+}
+
+// Execute validates one password per NDJSON line and streams one result line back
+// per input line. Lines are read and answered one at a time, so memory stays bounded
+// by streamMaxLineSize and a client that stops reading results stops the validation.
+// Streamed passwords are only evaluated: they are neither saved nor logged.
+func (c ValidatePasswordStreamController) Execute(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context())
+	log.Info("ValidatePasswordStreamController controller initialized")
+	newCtx, span := oteltrace.NewSpan(r.Context(), "password-validator", "password-stream-span")
+	defer span.End()
+	defer r.Body.Close()
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != response.NDJSONContentType {
+		err := _errors.UnsupportedMediaTypeError{ContentType: r.Header.Get("Content-Type"), Supported: []string{response.NDJSONContentType}, Cause: err}
+		log.Error("Invalid stream content type", err)
+		span.SetStatus(codes.Error, "ValidatePasswordStreamController Error")
+		span.RecordError(err)
+		handler.HandleErrors(w, r, err, nil)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	if err := rc.EnableFullDuplex(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Error("Error enabling full duplex on stream", err)
+	}
+
+	stream := response.NewStream(w, streamWriteTimeout)
+	stream.Start(http.StatusOK)
+
+	reader := bufio.NewReaderSize(r.Body, streamMaxLineSize)
+	lines := 0
+	for {
+		if err := newCtx.Err(); err != nil {
+			log.Error("Stream cancelled", err)
+			span.SetStatus(codes.Error, "ValidatePasswordStreamController Error")
+			span.RecordError(err)
+			return
+		}
+		if err := rc.SetReadDeadline(time.Now().Add(streamReadTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			log.Error("Error setting stream read deadline", err)
+		}
+
+		raw, tooLong, readErr := readLine(reader)
+		if readErr != nil && readErr != io.EOF {
+			log.Error("Error reading stream line", readErr)
+			span.SetStatus(codes.Error, "ValidatePasswordStreamController Error")
+			span.RecordError(readErr)
+			return
+		}
+
+		raw = bytes.TrimSpace(raw)
+		if tooLong || len(raw) > 0 {
+			lines++
+			if err := stream.Send(c.validateLine(newCtx, lines, raw, tooLong)); err != nil {
+				log.Error("Error writing stream line", err)
+				span.SetStatus(codes.Error, "ValidatePasswordStreamController Error")
+				span.RecordError(err)
+				return
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	log.WithFields(logger.Field{"lines": lines}).Info("ValidatePasswordStreamController controller finished")
+	span.AddEvent("Finished ValidatePasswordStreamController execution")
+	span.SetStatus(codes.Ok, "ValidatePasswordStreamController execution finished with success")
+}
+
+func (c ValidatePasswordStreamController) validateLine(ctx context.Context, line int, raw []byte, tooLong bool) validatePasswordStreamLine {
+	result := validatePasswordStreamLine{Line: line}
+	if tooLong {
+		result.Error = fmt.Sprintf("line exceeds %d bytes", streamMaxLineSize)
+		return result
+	}
+
+	var i input.PasswordInput
+	if err := json.Unmarshal(raw, &i); err != nil {
+		result.Error = fmt.Sprintf("invalid JSON: %s", err.Error())
+		return result
+	}
+
+	out, err := c.evaluatePasswordUseCase.Execute(ctx, i)
+	result.PasswordOutput = out
+	if err != nil {
+		var invalidField _errors.InvalidField
+		if !errors.As(err, &invalidField) {
+			logger.FromContext(ctx).Error("Error validating stream line", err)
+		}
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// readLine returns the next line from reader. Lines longer than the reader buffer are
+// discarded instead of being accumulated, and reported through tooLong.
+func readLine(reader *bufio.Reader) ([]byte, bool, error) {
+	line, err := reader.ReadSlice('\n')
+	if err != bufio.ErrBufferFull {
+		return line, false, err
+	}
+	for err == bufio.ErrBufferFull {
+		_, err = reader.ReadSlice('\n')
+	}
+	return nil, true, err
+}
@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/core/domain/tenant"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"password-validator/infrastructure/container"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidatePasswordStreamController(t *testing.T) {
+	tt := []struct {
+		name           string
+		contentType    string
+		stringBody     string
+		expectedStatus int
+		expectedLines  []validatePasswordStreamLine
+	}{
+		{
+			name:           "one result per line",
+			contentType:    "application/x-ndjson",
+			stringBody:     "{\"password\":\"AbTp9!fok\"}\n\n{\"password\":\"AbTp9!foA\"}\n{\"password\":\"AbTp9!fok\"}",
+			expectedStatus: http.StatusOK,
+			expectedLines: []validatePasswordStreamLine{
+				{Line: 1, PasswordOutput: output.PasswordOutput{IsValid: true}},
+				{Line: 2, Error: "Field [password] is invalid. Must not contain repeated characters."},
+				{Line: 3, PasswordOutput: output.PasswordOutput{IsValid: true}},
+			},
+		},
+		{
+			name:           "malformed and oversized lines are reported",
+			contentType:    "application/x-ndjson; charset=utf-8",
+			stringBody:     "error\n{\"password\":\"" + strings.Repeat("a", streamMaxLineSize) + "\"}\n{\"password\":\"AbTp9!fok\"}\n",
+			expectedStatus: http.StatusOK,
+			expectedLines: []validatePasswordStreamLine{
+				{Line: 1, Error: "invalid JSON: invalid character 'e' looking for beginning of value"},
+				{Line: 2, Error: "line exceeds 65536 bytes"},
+				{Line: 3, PasswordOutput: output.PasswordOutput{IsValid: true}},
+			},
+		},
+		{
+			name:           "unsupported content type",
+			contentType:    "application/json",
+			stringBody:     `{"password":"AbTp9!fok"}`,
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := &http.Request{
+				Header: http.Header{"Content-Type": []string{test.contentType}},
+				Body:   io.NopCloser(strings.NewReader(test.stringBody)),
+			}
+			uc := &ValidatePasswordUseCaseMock{}
+			uc.On("Execute", mock.Anything, input.PasswordInput{Password: "AbTp9!fok"}).
+				Return(output.PasswordOutput{IsValid: true}, nil)
+			uc.On("Execute", mock.Anything, input.PasswordInput{Password: "AbTp9!foA"}).
+				Return(output.PasswordOutput{}, _errors.InvalidField{Field: "password", AsIs: "Must not contain repeated characters"})
+			c := NewValidatePasswordStreamController(uc)
+
+			c.Execute(w, req)
+
+			assert.Equal(t, w.Result().StatusCode, test.expectedStatus)
+			if test.expectedLines == nil {
+				return
+			}
+			assert.Equal(t, w.Header().Get("Content-Type"), "application/x-ndjson")
+			decoder := json.NewDecoder(w.Body)
+			for _, expected := range test.expectedLines {
+				var line validatePasswordStreamLine
+				assert.NoError(t, decoder.Decode(&line))
+				assert.Equal(t, expected, line)
+			}
+			assert.False(t, decoder.More())
+		})
+	}
+}
+
+func TestValidatePasswordStreamControllerDoesNotSavePasswords(t *testing.T) {
+	c := container.New(time.Second, tenant.Registry{}, nil, nil)
+	w := httptest.NewRecorder()
+	req := &http.Request{
+		Header: http.Header{"Content-Type": []string{"application/x-ndjson"}},
+		Body:   io.NopCloser(strings.NewReader("{\"password\":\"AbTp9!fok\"}\n{\"password\":\"AbTp9!foA\"}\n")),
+	}
+
+	NewValidatePasswordStreamController(c.EvaluatePasswordUseCase).Execute(w, req.WithContext(context.Background()))
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	passwords, err := c.PasswordRepository.FindAll(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, passwords)
+}
@@ -1,21 +1,123 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"math"
 	"net/http"
 	"password-validator/adapter/response"
 	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/output"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
 )
 
-func HandleErrors(w http.ResponseWriter, err error, output interface{}) {
-	var status int
+// ProblemTypePrefix starts the type of every problem with a known cause. Problems
+// without one have the RFC 7807 type about:blank.
+const ProblemTypePrefix = "urn:password-validator:problem:"
+
+// errInternal is answered in place of errors of no known type, whose text may reveal
+// internals of the service.
+var errInternal = errors.New("An unexpected error occurred.")
+
+type legacyErrorsKey struct{}
+
+// WithLegacyErrors returns a copy of ctx whose errors are answered with the legacy
+// {"error": ..., "password": ...} body instead of problem details.
+func WithLegacyErrors(ctx context.Context) context.Context {
+	return context.WithValue(ctx, legacyErrorsKey{}, true)
+}
+
+// HandleErrors answers err with the status of its type, as RFC 7807 problem details
+// or with the legacy body when the request context asks for it. Errors of no known
+// type are answered with 500 and a generic detail; their cause is only logged and
+// recorded on the span of the request.
+func HandleErrors(w http.ResponseWriter, r *http.Request, err error, out interface{}) {
+	status, problemType, title := classify(err)
+	if status == http.StatusInternalServerError {
+		logger.FromContext(r.Context()).Error("Unexpected error handling request", err)
+		span := trace.SpanFromContext(r.Context())
+		span.SetStatus(codes.Error, "Unexpected error")
+		span.RecordError(err)
+		err = errInternal
+	}
+	var rateLimited _errors.RateLimitedError
+	if errors.As(err, &rateLimited) && rateLimited.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(rateLimited.RetryAfter.Seconds()))))
+	}
+
+	if legacy, _ := r.Context().Value(legacyErrorsKey{}).(bool); legacy {
+		response.NewError(err, status, out).Send(w)
+		return
+	}
+
+	problem := response.NewProblem(problemType, title, err, status, out)
+	problem.Code = _errors.Code(err)
+	if r.URL != nil {
+		problem.Instance = r.URL.Path
+	}
+	if span := trace.SpanContextFromContext(r.Context()); span.HasTraceID() {
+		problem.TraceID = span.TraceID().String()
+	}
+	problem.Errors = problemErrors(err, out)
+	problem.Send(w)
+}
+
+// classify returns the HTTP status, problem type and title of err.
+func classify(err error) (int, string, string) {
 	switch {
+	case errors.As(err, &_errors.MalformedRequestError{}):
+		return http.StatusBadRequest, problemType(err), "Malformed request"
+	case errors.As(err, &_errors.UnauthorizedError{}):
+		return http.StatusUnauthorized, problemType(err), "Unauthorized"
 	case errors.As(err, &_errors.NotFoundError{}):
-		status = http.StatusNotFound
+		return http.StatusNotFound, ProblemTypePrefix + "not-found", "Resource not found"
+	case errors.As(err, &_errors.PayloadTooLargeError{}):
+		return http.StatusRequestEntityTooLarge, problemType(err), "Payload too large"
+	case errors.As(err, &_errors.UnsupportedMediaTypeError{}):
+		return http.StatusUnsupportedMediaType, problemType(err), "Unsupported media type"
 	case errors.As(err, &_errors.InvalidField{}):
-		status = http.StatusUnprocessableEntity
+		return http.StatusUnprocessableEntity, problemType(err), "Invalid field"
+	case errors.As(err, &_errors.RateLimitedError{}):
+		return http.StatusTooManyRequests, problemType(err), "Rate limited"
+	case errors.As(err, &_errors.DependencyUnavailableError{}):
+		return http.StatusServiceUnavailable, problemType(err), "Dependency unavailable"
 	default:
-		status = http.StatusInternalServerError
+		return http.StatusInternalServerError, "about:blank", http.StatusText(http.StatusInternalServerError)
+	}
+}
+
+func problemType(err error) string {
+	return ProblemTypePrefix + strings.ReplaceAll(_errors.Code(err), "_", "-")
+}
+
+// problemErrors lists every violation of an invalid password, the single field of
+// any other InvalidField or MalformedRequestError, or the entity and ID of a missing
+// resource in params.
+func problemErrors(err error, out interface{}) []response.ProblemError {
+	var notFound _errors.NotFoundError
+	if errors.As(err, &notFound) {
+		return []response.ProblemError{{Code: notFound.Code(), Message: notFound.Error(), Params: map[string]any{"entity": notFound.Entity, "id": notFound.ID}}}
+	}
+	var malformed _errors.MalformedRequestError
+	if errors.As(err, &malformed) && malformed.Field != "" && malformed.Cause != nil {
+		return []response.ProblemError{{Code: _errors.CodeMalformedRequest, Field: malformed.Field, Message: malformed.Cause.Error()}}
+	}
+	var invalidField _errors.InvalidField
+	if !errors.As(err, &invalidField) {
+		return nil
+	}
+	if password, ok := out.(output.PasswordOutput); ok && len(password.Violations) > 0 {
+		problemErrors := make([]response.ProblemError, 0, len(password.Violations))
+		for _, v := range password.Violations {
+			problemErrors = append(problemErrors, response.ProblemError{Code: v.Code, Field: invalidField.Field, Message: v.Message, Params: v.Params})
+		}
+		return problemErrors
 	}
-	response.NewError(err, status, output).Send(w)
+	return []response.ProblemError{{Field: invalidField.Field, Message: invalidField.AsIs}}
 }
@@ -1,13 +1,19 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"password-validator/adapter/response"
 	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/output"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestHandleErrors(t *testing.T) {
@@ -26,6 +32,41 @@ func TestHandleErrors(t *testing.T) {
 			err:        _errors.InvalidField{},
 			statusCode: http.StatusUnprocessableEntity,
 		},
+		{
+			name:       "UnauthorizedError should return status unauthorized",
+			err:        _errors.UnauthorizedError{},
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "MalformedRequestError should return status bad request",
+			err:        _errors.MalformedRequestError{Cause: errors.New("unexpected end of JSON input")},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "UnsupportedMediaTypeError should return status unsupported media type",
+			err:        _errors.UnsupportedMediaTypeError{ContentType: "text/plain"},
+			statusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:       "PayloadTooLargeError should return status request entity too large",
+			err:        _errors.PayloadTooLargeError{Limit: 8},
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "RateLimitedError should return status too many requests",
+			err:        _errors.RateLimitedError{},
+			statusCode: http.StatusTooManyRequests,
+		},
+		{
+			name:       "DependencyUnavailableError should return status service unavailable",
+			err:        _errors.DependencyUnavailableError{Dependency: "policy store", Cause: errors.New("connection refused")},
+			statusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "Wrapped errors should return the status of their type",
+			err:        fmt.Errorf("loading policy: %w", _errors.NotFoundError{Entity: "Policy"}),
+			statusCode: http.StatusNotFound,
+		},
 		{
 			name:       "Other error should return status internal server error",
 			err:        errors.New("other error"),
@@ -35,10 +76,94 @@ func TestHandleErrors(t *testing.T) {
 	for _, test := range tt {
 		t.Run(test.name, func(t *testing.T) {
 			w := httptest.NewRecorder()
-			HandleErrors(w, test.err, nil)
+			HandleErrors(w, httptest.NewRequest(http.MethodGet, "/password/policies", nil), test.err, nil)
 
 			assert.Equal(t, w.Result().StatusCode, test.statusCode)
+			assert.Equal(t, response.ProblemContentType, w.Header().Get("Content-Type"))
+		})
+	}
+
+}
+
+func TestHandleErrorsProblem(t *testing.T) {
+	tt := []struct {
+		name         string
+		err          error
+		output       interface{}
+		legacy       bool
+		expectedBody string
+	}{
+		{
+			name:         "not found",
+			err:          _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			expectedBody: `{"type":"urn:password-validator:problem:not-found","title":"Resource not found","status":404,"detail":"Policy not found with ID 'missing'","instance":"/password/validate","code":"policy_not_found","errors":[{"code":"policy_not_found","message":"Policy not found with ID 'missing'","params":{"entity":"Policy","id":"missing"}}]}`,
+		},
+		{
+			name: "every violation of an invalid password",
+			err:  _errors.InvalidField{Field: "password", AsIs: "Must have at least 9 characters"},
+			output: output.PasswordOutput{Violations: []output.Violation{
+				{Code: "min_length", Message: "Must have at least 9 characters", Params: map[string]any{"min": 9}},
+				{Code: "digit", Message: "Must contain at least one digit"},
+			}},
+			expectedBody: `{"type":"urn:password-validator:problem:invalid-field","title":"Invalid field","status":422,"detail":"Field [password] is invalid. Must have at least 9 characters.","instance":"/password/validate","code":"invalid_field",` +
+				`"errors":[{"code":"min_length","field":"password","message":"Must have at least 9 characters","params":{"min":9}},{"code":"digit","field":"password","message":"Must contain at least one digit"}],` +
+				`"password":{"isValid":false,"violations":[{"code":"min_length","message":"Must have at least 9 characters","params":{"min":9}},{"code":"digit","message":"Must contain at least one digit"}]}}`,
+		},
+		{
+			name:         "invalid field without violations",
+			err:          _errors.InvalidField{Field: "length", AsIs: "Must be at most 128"},
+			expectedBody: `{"type":"urn:password-validator:problem:invalid-field","title":"Invalid field","status":422,"detail":"Field [length] is invalid. Must be at most 128.","instance":"/password/validate","code":"invalid_field","errors":[{"field":"length","message":"Must be at most 128"}]}`,
+		},
+		{
+			name:         "unknown cause",
+			err:          errors.New("other error"),
+			expectedBody: `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"An unexpected error occurred.","instance":"/password/validate","code":"internal_error"}`,
+		},
+		{
+			name:         "unknown cause in the legacy body",
+			err:          errors.New("other error"),
+			legacy:       true,
+			expectedBody: `{"error":"An unexpected error occurred."}`,
+		},
+		{
+			name:         "legacy body",
+			err:          _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			legacy:       true,
+			expectedBody: `{"error":"Policy not found with ID 'missing'"}`,
+		},
+	}
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/password/validate", nil)
+			if test.legacy {
+				r = r.WithContext(WithLegacyErrors(r.Context()))
+			}
+			w := httptest.NewRecorder()
+			HandleErrors(w, r, test.err, test.output)
+
+			assert.JSONEq(t, test.expectedBody, w.Body.String())
 		})
 	}
+}
+
+func TestHandleErrorsTraceID(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	r := httptest.NewRequest(http.MethodPost, "/password/strength", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	HandleErrors(w, r, _errors.PayloadTooLargeError{Limit: 8}, nil)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.JSONEq(t, `{"type":"urn:password-validator:problem:payload-too-large","title":"Payload too large","status":413,"detail":"Payload too large. Request body exceeds 8 bytes.","instance":"/password/strength","code":"payload_too_large","traceId":"4bf92f3577b34da6a3ce929d0e0e4736"}`, w.Body.String())
+}
+
+func TestHandleErrorsRetryAfter(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	HandleErrors(w, httptest.NewRequest(http.MethodPost, "/password/validate", nil), _errors.RateLimitedError{RetryAfter: 1500 * time.Millisecond}, nil)
 
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
 }
@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"context"
+	"fmt"
+	"password-validator/core/domain/compliance"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/output"
+	"strings"
+)
+
+type compliancePresenter struct{}
+
+var _ usecase.CompliancePresenter = (*compliancePresenter)(nil)
+
+func NewCompliancePresenter() usecase.CompliancePresenter {
+	return &compliancePresenter{}
+}
+
+// Output groups the findings by standard, in the order of compliance.Standards.
+func (pr *compliancePresenter) Output(ctx context.Context, facts compliance.Facts, findings []compliance.Finding) output.ComplianceOutput {
+	out := output.ComplianceOutput{
+		Policy:        facts.Policy.Name,
+		PolicyVersion: facts.Policy.Version(),
+		Blocklist:     facts.Blocklist,
+		Standards:     make([]output.ComplianceStandard, 0, len(compliance.Standards)),
+	}
+	for _, standard := range compliance.Standards {
+		s := output.ComplianceStandard{Name: standard, Findings: []output.ComplianceFinding{}}
+		for _, f := range findings {
+			if f.Standard != standard {
+				continue
+			}
+			s.Findings = append(s.Findings, output.ComplianceFinding{
+				Reference:   f.Reference,
+				Requirement: f.Requirement,
+				Status:      f.Status,
+				Detail:      f.Detail,
+			})
+			switch f.Status {
+			case compliance.StatusMet:
+				out.Summary.Met++
+			case compliance.StatusGap:
+				out.Summary.Gaps++
+			default:
+				out.Summary.Manual++
+			}
+		}
+		out.Standards = append(out.Standards, s)
+	}
+	return out
+}
+
+// ComplianceMarkdown renders a compliance report as a Markdown document, with a table
+// of findings per standard.
+func ComplianceMarkdown(out output.ComplianceOutput) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Password policy compliance: %s\n\n", out.Policy)
+	fmt.Fprintf(&b, "- Policy version: `%s`\n", out.PolicyVersion)
+	fmt.Fprintf(&b, "- Organization blocklist: %s\n", yesNo(out.Blocklist))
+	fmt.Fprintf(&b, "- Findings: %d met, %d gaps, %d to verify manually\n", out.Summary.Met, out.Summary.Gaps, out.Summary.Manual)
+	for _, s := range out.Standards {
+		fmt.Fprintf(&b, "\n## %s\n\n", s.Name)
+		b.WriteString("| Reference | Requirement | Status | Detail |\n|---|---|---|---|\n")
+		for _, f := range s.Findings {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", f.Reference, f.Requirement, f.Status, strings.ReplaceAll(f.Detail, "|", "\\|"))
+		}
+	}
+	return b.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
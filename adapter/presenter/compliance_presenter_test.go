@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/compliance"
+	"password-validator/core/domain/policy"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompliancePresenter(t *testing.T) {
+	pr := NewCompliancePresenter()
+	p := policy.Policy{Name: "passphrase", MinLength: 16, AllowRepeated: true}
+
+	out := pr.Output(context.TODO(), compliance.Facts{Policy: p}, []compliance.Finding{
+		{Standard: compliance.PCI, Reference: "8.3.6", Requirement: "Passwords have at least 12 characters", Status: compliance.StatusMet, Detail: "minLength is 16"},
+		{Standard: compliance.NIST, Reference: "5.1.1.2", Requirement: "Context-specific words are rejected", Status: compliance.StatusGap, Detail: "no organization blocklist"},
+		{Standard: compliance.PCI, Reference: "8.3.7", Requirement: "New passwords differ from the last four used", Status: compliance.StatusManual},
+	})
+
+	assert.Equal(t, output.ComplianceOutput{
+		Policy:        "passphrase",
+		PolicyVersion: p.Version(),
+		Summary:       output.ComplianceSummary{Met: 1, Gaps: 1, Manual: 1},
+		Standards: []output.ComplianceStandard{
+			{Name: compliance.NIST, Findings: []output.ComplianceFinding{
+				{Reference: "5.1.1.2", Requirement: "Context-specific words are rejected", Status: compliance.StatusGap, Detail: "no organization blocklist"},
+			}},
+			{Name: compliance.PCI, Findings: []output.ComplianceFinding{
+				{Reference: "8.3.6", Requirement: "Passwords have at least 12 characters", Status: compliance.StatusMet, Detail: "minLength is 16"},
+				{Reference: "8.3.7", Requirement: "New passwords differ from the last four used", Status: compliance.StatusManual},
+			}},
+			{Name: compliance.ASVS, Findings: []output.ComplianceFinding{}},
+			{Name: compliance.ISO, Findings: []output.ComplianceFinding{}},
+		},
+	}, out)
+}
+
+func TestComplianceMarkdown(t *testing.T) {
+	md := ComplianceMarkdown(output.ComplianceOutput{
+		Policy:        "default",
+		PolicyVersion: "fa38b7e8dac9",
+		Summary:       output.ComplianceSummary{Gaps: 1},
+		Standards: []output.ComplianceStandard{
+			{Name: compliance.NIST, Findings: []output.ComplianceFinding{
+				{Reference: "5.1.1.2", Requirement: "No composition rules are imposed", Status: compliance.StatusGap, Detail: "composition rules present: a|b"},
+			}},
+		},
+	})
+
+	assert.Equal(t, "# Password policy compliance: default\n\n"+
+		"- Policy version: `fa38b7e8dac9`\n"+
+		"- Organization blocklist: no\n"+
+		"- Findings: 0 met, 1 gaps, 0 to verify manually\n"+
+		"\n## NIST SP 800-63B\n\n"+
+		"| Reference | Requirement | Status | Detail |\n|---|---|---|---|\n"+
+		"| 5.1.1.2 | No composition rules are imposed | gap | composition rules present: a\\|b |\n", md)
+}
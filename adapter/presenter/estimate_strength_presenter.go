@@ -0,0 +1,25 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/strength"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/output"
+)
+
+type estimateStrengthPresenter struct{}
+
+var _ usecase.EstimateStrengthPresenter = (*estimateStrengthPresenter)(nil)
+
+func NewEstimateStrengthPresenter() usecase.EstimateStrengthPresenter {
+	return &estimateStrengthPresenter{}
+}
+
+func (p *estimateStrengthPresenter) Output(ctx context.Context, s strength.Strength) output.StrengthOutput {
+	return output.StrengthOutput{
+		Score:      s.Score,
+		Entropy:    s.Entropy,
+		Label:      s.Label,
+		CommonRank: s.CommonRank,
+	}
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/strength"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateStrengthPresenter(t *testing.T) {
+	p := NewEstimateStrengthPresenter()
+	for _, password := range []string{"AbTp9!fok", "Welcome1!"} {
+		s := strength.Estimate(password)
+
+		out := p.Output(context.TODO(), s)
+
+		assert.Equal(t, output.StrengthOutput{Score: s.Score, Entropy: s.Entropy, Label: s.Label, CommonRank: s.CommonRank}, out)
+	}
+}
@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/password"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/output"
+)
+
+type generatePasswordPresenter struct{}
+
+var _ usecase.GeneratePasswordPresenter = (*generatePasswordPresenter)(nil)
+
+func NewGeneratePasswordPresenter() usecase.GeneratePasswordPresenter {
+	return &generatePasswordPresenter{}
+}
+
+func (p *generatePasswordPresenter) Output(ctx context.Context, password *password.Password) output.GenerateOutput {
+	return output.GenerateOutput{
+		Password: password.Password(),
+		Policy:   password.Policy().Name,
+	}
+}
@@ -0,0 +1,20 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratePasswordPresenter(t *testing.T) {
+	p, _ := password.New(password.WithPassword("AbTp9!fok"))
+	pr := NewGeneratePasswordPresenter()
+
+	out := pr.Output(context.TODO(), p)
+
+	assert.Equal(t, output.GenerateOutput{Password: "AbTp9!fok", Policy: policy.DefaultName}, out)
+}
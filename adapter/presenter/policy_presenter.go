@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/output"
+)
+
+type policyPresenter struct{}
+
+var _ usecase.PolicyPresenter = (*policyPresenter)(nil)
+
+func NewPolicyPresenter() usecase.PolicyPresenter {
+	return &policyPresenter{}
+}
+
+// Output describes p with the same rules, and rule messages, validation applies, in
+// the language of ctx. The blocklist rule is listed last when the tenant has one.
+func (pr *policyPresenter) Output(ctx context.Context, p policy.Policy, isDefault, blocklist bool) output.PolicyOutput {
+	rules := password.Rules(p)
+	if blocklist {
+		rules = append(rules, password.BlocklistRule())
+	}
+	out := output.PolicyOutput{
+		Name:                      p.Name,
+		Version:                   p.Version(),
+		Description:               p.Description,
+		Default:                   isDefault,
+		MinLength:                 p.MinLength,
+		MaxLength:                 p.MaxLength,
+		RequireDigit:              p.RequireDigit,
+		RequireLower:              p.RequireLower,
+		RequireUpper:              p.RequireUpper,
+		RequireSpecial:            p.RequireSpecial,
+		SpecialChars:              p.SpecialChars,
+		AllowRepeated:             p.AllowRepeated,
+		RejectCommon:              p.RejectCommon,
+		Whitespace:                p.WhitespaceMode(),
+		MaxConsecutive:            p.MaxConsecutive,
+		MaxOccurrences:            p.MaxOccurrences,
+		MinUnique:                 p.MinUnique,
+		CaseInsensitiveRepetition: p.CaseInsensitiveRepetition,
+		MinDigits:                 p.MinDigits,
+		MinLower:                  p.MinLower,
+		MinUpper:                  p.MinUpper,
+		MinSpecial:                p.MinSpecial,
+		MinClasses:                p.MinClasses,
+		ASCIIClasses:              p.ASCIIClasses,
+		AnySpecial:                p.AnySpecial,
+		Blocklist:                 blocklist,
+		Rules:                     make([]output.PolicyRule, 0, len(rules)),
+	}
+	language := locale.FromContext(ctx)
+	for _, rule := range rules {
+		out.Rules = append(out.Rules, output.PolicyRule{Code: rule.Code, Message: password.Message(language, rule.Code, rule.Params), Params: rule.Params})
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"context"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/policy"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyPresenter(t *testing.T) {
+	pr := NewPolicyPresenter()
+
+	passphrase := policy.Policy{Name: "passphrase", MinLength: 16, AllowRepeated: true, Whitespace: policy.WhitespaceCount}
+
+	out := pr.Output(context.TODO(), passphrase, false, false)
+
+	assert.Equal(t, output.PolicyOutput{
+		Name:          "passphrase",
+		Version:       passphrase.Version(),
+		MinLength:     16,
+		AllowRepeated: true,
+		Whitespace:    policy.WhitespaceCount,
+		Rules: []output.PolicyRule{
+			{Code: "min_length", Message: "Must have at least 16 characters", Params: map[string]any{"min": 16, "whitespace": policy.WhitespaceCount}},
+		},
+	}, out)
+
+	out = pr.Output(locale.NewContext(context.TODO(), locale.Spanish), passphrase, false, false)
+
+	assert.Equal(t, "Debe tener al menos 16 caracteres", out.Rules[0].Message)
+}
+
+func TestPolicyPresenterListsCommonPasswordAndBlocklistRules(t *testing.T) {
+	pr := NewPolicyPresenter()
+	p := policy.Policy{Name: "common", MinLength: 12, AllowRepeated: true, RejectCommon: true}
+
+	tt := []struct {
+		name          string
+		blocklist     bool
+		expectedCodes []string
+	}{
+		{name: "without blocklist", expectedCodes: []string{"min_length", "common_password"}},
+		{name: "with blocklist", blocklist: true, expectedCodes: []string{"min_length", "common_password", "blocklisted_term"}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			out := pr.Output(locale.NewContext(context.TODO(), locale.PortugueseBR), p, false, test.blocklist)
+
+			var codes []string
+			for _, rule := range out.Rules {
+				codes = append(codes, rule.Code)
+			}
+			assert.Equal(t, test.expectedCodes, codes)
+			assert.True(t, out.RejectCommon)
+			assert.Equal(t, test.blocklist, out.Blocklist)
+			assert.Equal(t, "Não deve ser uma senha comum, mesmo com dígitos ou símbolos no final", out.Rules[1].Message)
+		})
+	}
+}
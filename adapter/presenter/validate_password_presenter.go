@@ -2,9 +2,11 @@ package presenter
 
 import (
 	"context"
-	"password-validator/core/domain/password"
+	"password-validator/core/domain/locale"
+	_password "password-validator/core/domain/password"
 	"password-validator/core/usecase"
 	"password-validator/core/usecase/output"
+	"unicode/utf8"
 )
 
 type validatePasswordPresenter struct{}
@@ -15,8 +17,25 @@ func NewValidatePasswordPresenter() usecase.ValidatePasswordPresenter {
 	return &validatePasswordPresenter{}
 }
 
-func (p *validatePasswordPresenter) Output(ctx context.Context, password *password.Password) output.PasswordOutput {
+func (p *validatePasswordPresenter) Output(ctx context.Context, password *_password.Password) output.PasswordOutput {
+	language := locale.FromContext(ctx)
+	var violations []output.Violation
+	for _, v := range password.Violations() {
+		violations = append(violations, output.Violation{
+			Code:    v.Code,
+			Message: _password.Message(language, v.Code, v.Params),
+			Params:  v.Params,
+		})
+	}
 	return output.PasswordOutput{
-		IsValid: password.IsValid(),
+		IsValid:       password.IsValid(),
+		Policy:        password.Policy().Name,
+		PolicyVersion: password.Policy().Version(),
+		Violations:    violations,
+		Satisfied:     password.Satisfied(),
+		Evaluated: &output.Evaluated{
+			Whitespace: password.Policy().WhitespaceMode(),
+			Length:     utf8.RuneCountInString(password.Evaluated()),
+		},
 	}
 }
@@ -2,7 +2,9 @@ package presenter
 
 import (
 	"context"
+	"password-validator/core/domain/locale"
 	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
 	"password-validator/core/usecase/output"
 	"testing"
 
@@ -11,23 +13,71 @@ import (
 
 func TestValidatePasswordPresenter(t *testing.T) {
 	p, _ := password.New(password.WithPassword("123"))
+	valid, _ := password.New(password.WithPassword("AbTp9!fok"))
 	tt := []struct {
-		name   string
-		input  *password.Password
-		output output.PasswordOutput
+		name     string
+		language string
+		input    *password.Password
+		output   output.PasswordOutput
 	}{
 		{
-			name:   "success parse",
-			input:  p,
-			output: output.PasswordOutput{IsValid: p.IsValid()},
+			name:  "success parse",
+			input: p,
+			output: output.PasswordOutput{
+				IsValid:       p.IsValid(),
+				Policy:        policy.DefaultName,
+				PolicyVersion: policy.Default().Version(),
+				Violations: []output.Violation{
+					{Code: password.CodeMinLength, Message: "Must have at least 9 characters (excluding spaces)", Params: map[string]any{"min": 9, "whitespace": policy.WhitespaceStrip}},
+					{Code: password.CodeLowercase, Message: "Must contain at least one lowercase letter (excluding spaces)", Params: map[string]any{"min": 1}},
+					{Code: password.CodeUppercase, Message: "Must contain at least one uppercase letter (excluding spaces)", Params: map[string]any{"min": 1}},
+					{Code: password.CodeSpecialCharacter, Message: "Must contain at least one special character (!@#$%^&*()-+, excluding spaces)", Params: map[string]any{"min": 1, "characters": "!@#$%^&*()-+"}},
+				},
+				Satisfied: []string{password.CodeUniqueCharacters, password.CodeDigit},
+				Evaluated: &output.Evaluated{Whitespace: policy.WhitespaceStrip, Length: 3},
+			},
+		},
+		{
+			name:     "messages in the request language",
+			language: locale.PortugueseBR,
+			input:    p,
+			output: output.PasswordOutput{
+				IsValid:       false,
+				Policy:        policy.DefaultName,
+				PolicyVersion: policy.Default().Version(),
+				Violations: []output.Violation{
+					{Code: password.CodeMinLength, Message: "Deve ter pelo menos 9 caracteres (sem contar espaços)", Params: map[string]any{"min": 9, "whitespace": policy.WhitespaceStrip}},
+					{Code: password.CodeLowercase, Message: "Deve conter pelo menos uma letra minúscula (sem contar espaços)", Params: map[string]any{"min": 1}},
+					{Code: password.CodeUppercase, Message: "Deve conter pelo menos uma letra maiúscula (sem contar espaços)", Params: map[string]any{"min": 1}},
+					{Code: password.CodeSpecialCharacter, Message: "Deve conter pelo menos um caractere especial (!@#$%^&*()-+, sem contar espaços)", Params: map[string]any{"min": 1, "characters": "!@#$%^&*()-+"}},
+				},
+				Satisfied: []string{password.CodeUniqueCharacters, password.CodeDigit},
+				Evaluated: &output.Evaluated{Whitespace: policy.WhitespaceStrip, Length: 3},
+			},
+		},
+		{
+			name:  "valid password has no violations",
+			input: valid,
+			output: output.PasswordOutput{
+				IsValid:       true,
+				Policy:        policy.DefaultName,
+				PolicyVersion: policy.Default().Version(),
+				Satisfied:     valid.Satisfied(),
+				Evaluated:     &output.Evaluated{Whitespace: policy.WhitespaceStrip, Length: 9},
+			},
 		},
 	}
 	for _, test := range tt {
 		t.Run(test.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if test.language != "" {
+				ctx = locale.NewContext(ctx, test.language)
+			}
 			p := NewValidatePasswordPresenter()
-			out := p.Output(context.TODO(), test.input)
+			out := p.Output(ctx, test.input)
 
 			assert.Equal(t, test.output.IsValid, out.IsValid)
+			assert.Equal(t, test.output, out)
 		})
 	}
 }
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/blocklist"
+	"sync/atomic"
+)
+
+type BlocklistRepository struct {
+	blocklist atomic.Pointer[blocklist.Blocklist]
+}
+
+func NewBlocklistRepository(b blocklist.Blocklist) *BlocklistRepository {
+	r := &BlocklistRepository{}
+	r.blocklist.Store(&b)
+	return r
+}
+
+// Replace atomically swaps the term list. Requests already holding the previous list
+// finish with it.
+func (r *BlocklistRepository) Replace(b blocklist.Blocklist) {
+	r.blocklist.Store(&b)
+}
+
+func (r *BlocklistRepository) Find(ctx context.Context) (blocklist.Blocklist, error) {
+	return *r.blocklist.Load(), nil
+}
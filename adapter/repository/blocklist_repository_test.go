@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/tenant"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaceBlocklistRepository(t *testing.T) {
+	repo := NewBlocklistRepository(blocklist.New([]string{"acme"}, blocklist.DefaultSubstringLength))
+
+	repo.Replace(blocklist.New([]string{"globex"}, blocklist.DefaultSubstringLength))
+
+	b, err := repo.Find(context.TODO())
+	assert.NoError(t, err)
+	assert.False(t, b.Match("acme"))
+	assert.True(t, b.Match("globex"))
+}
+
+func TestFindTenantBlocklistRepository(t *testing.T) {
+	repo := NewTenantBlocklistRepository(map[string]*BlocklistRepository{
+		"cards": NewBlocklistRepository(blocklist.New([]string{"acme"}, blocklist.DefaultSubstringLength)),
+	})
+	tt := []struct {
+		name   string
+		tenant string
+		output bool
+	}{
+		{name: "tenant list", tenant: "cards", output: true},
+		{name: "tenant without a list", tenant: tenant.Default, output: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := repo.Find(tenant.NewContext(context.TODO(), test.tenant))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.output, b.Match("acme"))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+	"sync/atomic"
+)
+
+type PolicyRepository struct {
+	document atomic.Pointer[policy.Document]
+}
+
+func NewPolicyRepository(document policy.Document) *PolicyRepository {
+	r := &PolicyRepository{}
+	r.document.Store(&document)
+	return r
+}
+
+// Replace atomically swaps the policies served by the repository. The policies it
+// replaces are kept in the history, so their versions stay addressable. Requests
+// already holding a policy finish with it.
+func (r *PolicyRepository) Replace(document policy.Document) {
+	for {
+		previous := r.document.Load()
+		next := document.Supersede(*previous)
+		if r.document.CompareAndSwap(previous, &next) {
+			return
+		}
+	}
+}
+
+func (r *PolicyRepository) FindByName(ctx context.Context, name string) (policy.Policy, error) {
+	return r.document.Load().Find(name)
+}
+
+func (r *PolicyRepository) FindAll(ctx context.Context) ([]policy.Policy, error) {
+	return r.document.Load().Policies, nil
+}
+
+func (r *PolicyRepository) FindVersions(ctx context.Context, name string) ([]policy.Policy, error) {
+	return r.document.Load().Versions(name)
+}
+
+func (r *PolicyRepository) FindVersion(ctx context.Context, name, version string) (policy.Policy, error) {
+	return r.document.Load().FindVersion(name, version)
+}
+
+func (r *PolicyRepository) FindShadow(ctx context.Context, name string) (policy.Policy, error) {
+	return r.document.Load().Shadow(name)
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindByNamePolicyRepository(t *testing.T) {
+	strict := policy.Policy{Name: "strict", MinLength: 12}
+	tt := []struct {
+		name        string
+		input       string
+		output      policy.Policy
+		expectedErr error
+	}{
+		{
+			name:   "default policy",
+			input:  "",
+			output: policy.Default(),
+		},
+		{
+			name:   "named policy",
+			input:  "strict",
+			output: strict,
+		},
+		{
+			name:   "policy not found",
+			input:  "missing",
+			output: policy.Policy{},
+			expectedErr: _errors.NotFoundError{
+				Entity: "Policy",
+				ID:     "missing",
+			},
+		},
+	}
+
+	repo := NewPolicyRepository(policy.Document{
+		Default:  policy.DefaultName,
+		Policies: []policy.Policy{policy.Default(), strict},
+	})
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := repo.FindByName(context.TODO(), test.input)
+
+			assert.Equal(t, err, test.expectedErr)
+			assert.Equal(t, p, test.output)
+		})
+	}
+}
+
+func TestFindAllPolicyRepository(t *testing.T) {
+	strict := policy.Policy{Name: "strict", MinLength: 12}
+	repo := NewPolicyRepository(policy.Document{
+		Default:  policy.DefaultName,
+		Policies: []policy.Policy{policy.Default(), strict},
+	})
+
+	policies, err := repo.FindAll(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []policy.Policy{policy.Default(), strict}, policies)
+}
+
+func TestFindVersionPolicyRepository(t *testing.T) {
+	retired := policy.Default()
+	retired.MinLength = 8
+	repo := NewPolicyRepository(policy.Document{
+		Default:  policy.DefaultName,
+		Policies: []policy.Policy{policy.Default()},
+		History:  []policy.Policy{retired},
+	})
+
+	versions, err := repo.FindVersions(context.TODO(), policy.DefaultName)
+	assert.NoError(t, err)
+	assert.Equal(t, []policy.Policy{policy.Default(), retired}, versions)
+
+	p, err := repo.FindVersion(context.TODO(), policy.DefaultName, retired.Version())
+	assert.NoError(t, err)
+	assert.Equal(t, retired, p)
+}
+
+func TestReplacePolicyRepository(t *testing.T) {
+	tightened := policy.Default()
+	tightened.MinLength = 12
+	repo := NewPolicyRepository(policy.DefaultDocument())
+
+	repo.Replace(policy.Document{Default: policy.DefaultName, Policies: []policy.Policy{tightened}})
+
+	p, err := repo.FindByName(context.TODO(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, tightened, p)
+	versions, err := repo.FindVersions(context.TODO(), policy.DefaultName)
+	assert.NoError(t, err)
+	assert.Equal(t, []policy.Policy{tightened, policy.Default()}, versions)
+}
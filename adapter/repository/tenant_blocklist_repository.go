@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/tenant"
+)
+
+// TenantBlocklistRepository serves the term list of the tenant in the context.
+// Tenants without a list get an empty one, which matches nothing.
+type TenantBlocklistRepository struct {
+	tenants map[string]*BlocklistRepository
+}
+
+func NewTenantBlocklistRepository(tenants map[string]*BlocklistRepository) *TenantBlocklistRepository {
+	return &TenantBlocklistRepository{
+		tenants: tenants,
+	}
+}
+
+func (r *TenantBlocklistRepository) Find(ctx context.Context) (blocklist.Blocklist, error) {
+	terms, ok := r.tenants[tenant.FromContext(ctx)]
+	if !ok {
+		return blocklist.Blocklist{}, nil
+	}
+	return terms.Find(ctx)
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/tenant"
+	_errors "password-validator/core/errors"
+)
+
+// TenantPolicyRepository serves the policies of the tenant in the context, so a
+// tenant never sees the policies of another.
+type TenantPolicyRepository struct {
+	tenants map[string]*PolicyRepository
+}
+
+func NewTenantPolicyRepository(tenants map[string]*PolicyRepository) *TenantPolicyRepository {
+	return &TenantPolicyRepository{
+		tenants: tenants,
+	}
+}
+
+func (r *TenantPolicyRepository) tenant(ctx context.Context) (*PolicyRepository, error) {
+	id := tenant.FromContext(ctx)
+	policies, ok := r.tenants[id]
+	if !ok {
+		return nil, _errors.NotFoundError{
+			Entity: "Tenant",
+			ID:     id,
+		}
+	}
+	return policies, nil
+}
+
+func (r *TenantPolicyRepository) FindByName(ctx context.Context, name string) (policy.Policy, error) {
+	policies, err := r.tenant(ctx)
+	if err != nil {
+		return policy.Policy{}, err
+	}
+	return policies.FindByName(ctx, name)
+}
+
+func (r *TenantPolicyRepository) FindAll(ctx context.Context) ([]policy.Policy, error) {
+	policies, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return policies.FindAll(ctx)
+}
+
+func (r *TenantPolicyRepository) FindVersions(ctx context.Context, name string) ([]policy.Policy, error) {
+	policies, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return policies.FindVersions(ctx, name)
+}
+
+func (r *TenantPolicyRepository) FindVersion(ctx context.Context, name, version string) (policy.Policy, error) {
+	policies, err := r.tenant(ctx)
+	if err != nil {
+		return policy.Policy{}, err
+	}
+	return policies.FindVersion(ctx, name, version)
+}
+
+func (r *TenantPolicyRepository) FindShadow(ctx context.Context, name string) (policy.Policy, error) {
+	policies, err := r.tenant(ctx)
+	if err != nil {
+		return policy.Policy{}, err
+	}
+	return policies.FindShadow(ctx, name)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/tenant"
+	_errors "password-validator/core/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindByNameTenantPolicyRepository(t *testing.T) {
+	strict := policy.Policy{Name: "strict", MinLength: 12}
+	repo := NewTenantPolicyRepository(map[string]*PolicyRepository{
+		tenant.Default: NewPolicyRepository(policy.DefaultDocument()),
+		"cards":        NewPolicyRepository(policy.Document{Default: "strict", Policies: []policy.Policy{strict}}),
+	})
+	tt := []struct {
+		name        string
+		tenant      string
+		input       string
+		output      policy.Policy
+		expectedErr error
+	}{
+		{
+			name:   "default tenant",
+			tenant: tenant.Default,
+			output: policy.Default(),
+		},
+		{
+			name:   "tenant policies",
+			tenant: "cards",
+			input:  "strict",
+			output: strict,
+		},
+		{
+			name:        "policy of another tenant",
+			tenant:      tenant.Default,
+			input:       "strict",
+			expectedErr: _errors.NotFoundError{Entity: "Policy", ID: "strict"},
+		},
+		{
+			name:        "unknown tenant",
+			tenant:      "loans",
+			expectedErr: _errors.NotFoundError{Entity: "Tenant", ID: "loans"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := repo.FindByName(tenant.NewContext(context.TODO(), test.tenant), test.input)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.output, p)
+		})
+	}
+}
@@ -3,26 +3,36 @@ package repository
 import (
 	"context"
 	"password-validator/core/domain/password"
+	"password-validator/core/domain/tenant"
 	_errors "password-validator/core/errors"
+	"sync"
 )
 
+// PasswordRepository keeps the passwords of each tenant apart, under the tenant in
+// the context.
 type PasswordRepository struct {
-	storage []password.Password
+	mu      sync.RWMutex
+	storage map[string][]password.Password
 }
 
 func NewPasswordRepository() *PasswordRepository {
 	return &PasswordRepository{
-		storage: []password.Password{},
+		storage: map[string][]password.Password{},
 	}
 }
 
 func (r *PasswordRepository) Save(ctx context.Context, p *password.Password) error {
-	r.storage = append(r.storage, *p)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := tenant.FromContext(ctx)
+	r.storage[id] = append(r.storage[id], *p)
 	return nil
 }
 
 func (r *PasswordRepository) FindById(ctx context.Context, pa string) (*password.Password, error) {
-	for _, v := range r.storage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, v := range r.storage[tenant.FromContext(ctx)] {
 		if v.Password() == pa {
 			return &v, nil
 		}
@@ -32,3 +42,17 @@ func (r *PasswordRepository) FindById(ctx context.Context, pa string) (*password
 		ID:     pa,
 	}
 }
+
+// FindAll returns every stored password with the policy, and so the policy version,
+// it was validated under.
+func (r *PasswordRepository) FindAll(ctx context.Context) ([]*password.Password, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	storage := r.storage[tenant.FromContext(ctx)]
+	passwords := make([]*password.Password, 0, len(storage))
+	for i := range storage {
+		p := storage[i]
+		passwords = append(passwords, &p)
+	}
+	return passwords, nil
+}
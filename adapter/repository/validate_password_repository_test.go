@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"password-validator/core/domain/password"
+	"password-validator/core/domain/tenant"
 	_errors "password-validator/core/errors"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestSaveRepository(t *testing.T) {
 	p, _ := password.New(password.WithPassword("123"))
 	repo.Save(context.TODO(), p)
 
-	assert.Equal(t, repo.storage[0].IsValid(), p.IsValid())
+	assert.Equal(t, repo.storage[tenant.Default][0].IsValid(), p.IsValid())
 }
 
 func TestFindByIdRepository(t *testing.T) {
@@ -53,3 +54,34 @@ func TestFindByIdRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestFindAllRepository(t *testing.T) {
+	repo := NewPasswordRepository()
+	p, _ := password.New(password.WithPassword("AbTp9!fok"))
+	repo.Save(context.TODO(), p)
+
+	passwords, err := repo.FindAll(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Len(t, passwords, 1)
+	assert.Equal(t, p.Policy().Version(), passwords[0].Policy().Version())
+}
+
+func TestTenantIsolationRepository(t *testing.T) {
+	repo := NewPasswordRepository()
+	cards := tenant.NewContext(context.TODO(), "cards")
+	loans := tenant.NewContext(context.TODO(), "loans")
+	p, _ := password.New(password.WithPassword("AbTp9!fok"))
+	repo.Save(cards, p)
+
+	found, err := repo.FindAll(cards)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	found, err = repo.FindAll(loans)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+
+	_, err = repo.FindById(loans, "AbTp9!fok")
+	assert.Equal(t, _errors.NotFoundError{Entity: "Password", ID: "AbTp9!fok"}, err)
+}
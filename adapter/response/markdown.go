@@ -0,0 +1,26 @@
+package response
+
+import (
+	"io"
+	"net/http"
+)
+
+const MarkdownContentType = "text/markdown; charset=utf-8"
+
+type Markdown struct {
+	statusCode int
+	document   string
+}
+
+func NewMarkdown(document string, status int) Markdown {
+	return Markdown{
+		document:   document,
+		statusCode: status,
+	}
+}
+
+func (m Markdown) Send(writer http.ResponseWriter) {
+	writer.Header().Set("Content-Type", MarkdownContentType)
+	writer.WriteHeader(m.statusCode)
+	io.WriteString(writer, m.document)
+}
@@ -0,0 +1,50 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const ProblemContentType = "application/problem+json"
+
+type (
+	// Problem is an RFC 7807 problem details body. Errors lists what was wrong with
+	// the request one by one, such as every rule a password broke, and Output keeps
+	// the validation result when there is one.
+	Problem struct {
+		statusCode int
+		Type       string         `json:"type"`
+		Title      string         `json:"title"`
+		Status     int            `json:"status"`
+		Detail     string         `json:"detail,omitempty"`
+		Instance   string         `json:"instance,omitempty"`
+		Code       string         `json:"code,omitempty"`
+		TraceID    string         `json:"traceId,omitempty"`
+		Errors     []ProblemError `json:"errors,omitempty"`
+		Output     interface{}    `json:"password,omitempty"`
+	}
+
+	ProblemError struct {
+		Code    string         `json:"code,omitempty"`
+		Field   string         `json:"field,omitempty"`
+		Message string         `json:"message"`
+		Params  map[string]any `json:"params,omitempty"`
+	}
+)
+
+func NewProblem(problemType, title string, err error, status int, output interface{}) *Problem {
+	return &Problem{
+		statusCode: status,
+		Type:       problemType,
+		Title:      title,
+		Status:     status,
+		Detail:     err.Error(),
+		Output:     output,
+	}
+}
+
+func (p Problem) Send(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.statusCode)
+	json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,21 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblem(t *testing.T) {
+	w := httptest.NewRecorder()
+	problem := NewProblem("about:blank", "Not Found", errors.New("error test"), http.StatusNotFound, nil)
+	problem.Instance = "/password/policies/missing"
+	problem.Send(w)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	assert.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"error test","instance":"/password/policies/missing"}`, w.Body.String())
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const NDJSONContentType = "application/x-ndjson"
+
+type Stream struct {
+	writer       http.ResponseWriter
+	controller   *http.ResponseController
+	encoder      *json.Encoder
+	writeTimeout time.Duration
+}
+
+func NewStream(writer http.ResponseWriter, writeTimeout time.Duration) *Stream {
+	return &Stream{
+		writer:       writer,
+		controller:   http.NewResponseController(writer),
+		encoder:      json.NewEncoder(writer),
+		writeTimeout: writeTimeout,
+	}
+}
+
+func (s *Stream) Start(status int) {
+	s.writer.Header().Set("Content-Type", NDJSONContentType)
+	s.writer.WriteHeader(status)
+}
+
+// Send writes a single line and flushes it to the client. Each line gets its own
+// write deadline, so a slow reader blocks the stream instead of buffering results.
+func (s *Stream) Send(line interface{}) error {
+	if err := s.controller.SetWriteDeadline(time.Now().Add(s.writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if err := s.encoder.Encode(line); err != nil {
+		return err
+	}
+	if err := s.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	w := httptest.NewRecorder()
+	stream := NewStream(w, time.Second)
+	stream.Start(http.StatusOK)
+
+	assert.NoError(t, stream.Send(ExpectedSuccess{Test: "first"}))
+	assert.NoError(t, stream.Send(ExpectedSuccess{Test: "second"}))
+
+	assert.Equal(t, w.Result().StatusCode, http.StatusOK)
+	assert.Equal(t, w.Header().Get("Content-Type"), NDJSONContentType)
+	assert.Equal(t, "{\"Test\":\"first\"}\n{\"Test\":\"second\"}\n", w.Body.String())
+	assert.True(t, w.Flushed)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"password-validator/adapter/presenter"
+	"password-validator/adapter/repository"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/policy"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+)
+
+func runCompliance(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("compliance", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, "Usage: passwordctl compliance [flags]\n\n"+
+			"Reports the compliance of a policy with NIST SP 800-63B, PCI DSS 4.0, OWASP ASVS V2\n"+
+			"and ISO/IEC 27001.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	policyFile := flags.String("policy-file", "", "policy document to load (defaults to the built-in policy)")
+	policyName := flags.String("policy", "", "policy to assess (defaults to the document default)")
+	blocklistFile := flags.String("blocklist-file", "", "organization term list applied along with the policy")
+	format := flags.String("format", "markdown", "output format: 'markdown' or 'json'")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *format != "markdown" && *format != "json" {
+		fmt.Fprintf(stderr, "invalid -format %q\n", *format)
+		return exitUsage
+	}
+
+	document := policy.DefaultDocument()
+	if *policyFile != "" {
+		var err error
+		document, err = policy.LoadFile(*policyFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "error loading policy file: %v\n", err)
+			return exitUsage
+		}
+	}
+	var terms blocklist.Blocklist
+	if *blocklistFile != "" {
+		var err error
+		terms, err = blocklist.LoadFile(*blocklistFile, blocklist.DefaultSubstringLength)
+		if err != nil {
+			fmt.Fprintf(stderr, "error loading blocklist file: %v\n", err)
+			return exitUsage
+		}
+	}
+
+	complianceReportUseCase := usecase.NewComplianceReportUseCase(
+		repository.NewPolicyRepository(document),
+		repository.NewBlocklistRepository(terms),
+		presenter.NewCompliancePresenter(),
+	)
+	out, err := complianceReportUseCase.Execute(context.Background(), input.PolicyInput{Name: *policyName})
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return exitUsage
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(out)
+	} else {
+		fmt.Fprint(stdout, presenter.ComplianceMarkdown(out))
+	}
+	if out.Summary.Gaps > 0 {
+		return exitInvalid
+	}
+	return exitOK
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"password-validator/core/usecase/output"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCompliance(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "policies.json")
+	err := os.WriteFile(policyFile, []byte(`{"policies":[{"name":"passphrase","minLength":16,"allowRepeated":true}]}`), 0o600)
+	assert.NoError(t, err)
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"compliance"}, strings.NewReader(""), &stdout, &stderr)
+
+	assert.Equal(t, exitInvalid, code)
+	assert.True(t, strings.HasPrefix(stdout.String(), "# Password policy compliance: default\n"))
+	assert.Contains(t, stdout.String(), "| 5.1.1.2 | No composition rules are imposed | gap | composition rules present: digits, lowercase letters, uppercase letters, special characters, no repeated characters |\n")
+
+	stdout.Reset()
+	code = run([]string{"compliance", "-policy-file", policyFile, "-format", "json"}, strings.NewReader(""), &stdout, &stderr)
+
+	var out output.ComplianceOutput
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	assert.Equal(t, exitInvalid, code)
+	assert.Equal(t, "passphrase", out.Policy)
+	assert.Equal(t, "No composition rules are imposed", out.Standards[0].Findings[3].Requirement)
+	assert.Equal(t, "met", out.Standards[0].Findings[3].Status)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"password-validator/core/domain/directory"
+)
+
+func runImport(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, "Usage: passwordctl import [flags] [file.ldif...]\n\n"+
+			"Converts Active Directory and LDAP password policies exported as LDIF, from the\n"+
+			"files or stdin, into a policy document written to stdout.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	defaultName := flags.String("default", "", "policy to make the document default (defaults to the AD domain policy, or the first one)")
+	strict := flags.Bool("strict", false, "fail when a setting of the directory cannot be enforced")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	var entries []directory.Entry
+	if flags.NArg() == 0 {
+		var err error
+		entries, err = directory.ParseLDIF(stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%v\n", err)
+			return exitUsage
+		}
+	}
+	for _, path := range flags.Args() {
+		read, err := directory.LoadFile(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "error loading %s: %v\n", path, err)
+			return exitUsage
+		}
+		entries = append(entries, read...)
+	}
+
+	document, notes, err := directory.Import(entries)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return exitUsage
+	}
+	if *defaultName != "" {
+		if _, err := document.Find(*defaultName); err != nil {
+			fmt.Fprintf(stderr, "%v\n", err)
+			return exitUsage
+		}
+		document.Default = *defaultName
+	}
+	for _, note := range notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+	if *strict && len(notes) > 0 {
+		return exitInvalid
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		fmt.Fprintf(stderr, "error writing policy document: %v\n", err)
+		return exitUsage
+	}
+	return exitOK
+}
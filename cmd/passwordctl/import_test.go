@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ppolicyExport = `dn: cn=default,ou=policies,dc=example,dc=com
+objectClass: pwdPolicy
+cn: default
+pwdMinLength: 12
+pwdCheckQuality: 2
+
+dn: cn=history,ou=policies,dc=example,dc=com
+objectClass: pwdPolicy
+cn: history
+pwdInHistory: 3
+`
+
+func TestRunImport(t *testing.T) {
+	tt := []struct {
+		name           string
+		args           []string
+		stdin          string
+		expectedCode   int
+		expectedOut    string
+		expectedStderr string
+	}{
+		{
+			name:         "policy document",
+			args:         []string{"import", "-default", "history"},
+			stdin:        ppolicyExport,
+			expectedCode: exitOK,
+			expectedOut: `{
+  "default": "history",
+  "policies": [
+    {
+      "name": "default",
+      "description": "Imported from cn=default,ou=policies,dc=example,dc=com",
+      "minLength": 12,
+      "requireDigit": false,
+      "requireLower": false,
+      "requireUpper": false,
+      "requireSpecial": false,
+      "allowRepeated": true,
+      "whitespace": "count"
+    },
+    {
+      "name": "history",
+      "description": "Imported from cn=history,ou=policies,dc=example,dc=com",
+      "minLength": 0,
+      "requireDigit": false,
+      "requireLower": false,
+      "requireUpper": false,
+      "requireSpecial": false,
+      "allowRepeated": true,
+      "whitespace": "count"
+    }
+  ]
+}
+`,
+			expectedStderr: "note: default: lengths are counted in characters, while the directory counts bytes of non-ASCII passwords\n" +
+				"note: history: a history of 3 passwords is not enforced, the validator does not see previous passwords\n",
+		},
+		{
+			name:         "strict",
+			args:         []string{"import", "-strict"},
+			stdin:        ppolicyExport,
+			expectedCode: exitInvalid,
+			expectedStderr: "note: default: lengths are counted in characters, while the directory counts bytes of non-ASCII passwords\n" +
+				"note: history: a history of 3 passwords is not enforced, the validator does not see previous passwords\n",
+		},
+		{
+			name:           "unknown default",
+			args:           []string{"import", "-default", "missing"},
+			stdin:          ppolicyExport,
+			expectedCode:   exitUsage,
+			expectedStderr: "Policy not found with ID 'missing'\n",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(test.args, strings.NewReader(test.stdin), &stdout, &stderr)
+
+			assert.Equal(t, test.expectedCode, code)
+			assert.Equal(t, test.expectedOut, stdout.String())
+			assert.Equal(t, test.expectedStderr, stderr.String())
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestRunImportWriteError(t *testing.T) {
+	var stderr bytes.Buffer
+	code := run([]string{"import"}, strings.NewReader(ppolicyExport), failingWriter{}, &stderr)
+
+	assert.Equal(t, exitUsage, code)
+	assert.Contains(t, stderr.String(), "error writing policy document: broken pipe\n")
+}
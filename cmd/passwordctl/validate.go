@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"password-validator/adapter/presenter"
+	"password-validator/adapter/repository"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+)
+
+const maxLineSize = 1 << 20
+
+type validateResult struct {
+	Line int `json:"line"`
+	output.PasswordOutput
+	Error string `json:"error,omitempty"`
+}
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, "Usage: passwordctl validate [flags] [password...]\n\n"+
+			"Passwords are read from the arguments, or one per line from -file or stdin.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	policyFile := flags.String("policy-file", "", "policy document to load (defaults to the built-in policy)")
+	policyName := flags.String("policy", "", "policy to apply (defaults to the document default)")
+	blocklistFile := flags.String("blocklist-file", "", "organization term list to reject, one term per line")
+	substringLength := flags.Int("blocklist-substring-length", blocklist.DefaultSubstringLength, "shortest blocklist term matched inside a password")
+	confusables := flags.Bool("blocklist-confusables", false, "also match blocklist terms spelled with homoglyphs or accented letters")
+	file := flags.String("file", "-", "file to read passwords from, '-' for stdin")
+	inputFormat := flags.String("input", "lines", "input format: 'lines' (one raw password per line) or 'jsonl'")
+	format := flags.String("format", "text", "output format: 'text' or 'json'")
+	lang := flags.String("lang", locale.Default, "language of the violation messages: 'en', 'pt-BR' or 'es'")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *inputFormat != "lines" && *inputFormat != "jsonl" {
+		fmt.Fprintf(stderr, "invalid -input %q\n", *inputFormat)
+		return exitUsage
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(stderr, "invalid -format %q\n", *format)
+		return exitUsage
+	}
+
+	document := policy.DefaultDocument()
+	if *policyFile != "" {
+		var err error
+		document, err = policy.LoadFile(*policyFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "error loading policy file: %v\n", err)
+			return exitUsage
+		}
+	}
+	if _, err := document.Find(*policyName); err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return exitUsage
+	}
+	var terms blocklist.Blocklist
+	if *blocklistFile != "" {
+		var err error
+		terms, err = blocklist.LoadFile(*blocklistFile, *substringLength)
+		if err != nil {
+			fmt.Fprintf(stderr, "error loading blocklist file: %v\n", err)
+			return exitUsage
+		}
+		if *confusables {
+			terms = terms.WithConfusables()
+		}
+	}
+
+	// Audited passwords are only evaluated: they are neither kept, logged nor counted.
+	evaluatePasswordUseCase := usecase.NewEvaluatePasswordUseCase(
+		repository.NewPolicyRepository(document),
+		repository.NewBlocklistRepository(terms),
+		presenter.NewValidatePasswordPresenter(),
+	)
+	v := validator{
+		ctx:                     locale.NewContext(context.Background(), locale.Negotiate(*lang)),
+		evaluatePasswordUseCase: evaluatePasswordUseCase,
+		policy:                  *policyName,
+		jsonOutput:              *format == "json",
+		out:                     stdout,
+	}
+
+	if flags.NArg() > 0 {
+		v.label = "arg"
+		for n, arg := range flags.Args() {
+			v.validate(n+1, input.PasswordInput{Password: arg})
+		}
+		return v.finish()
+	}
+
+	reader := stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			fmt.Fprintf(stderr, "error opening input file: %v\n", err)
+			return exitUsage
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	v.label = "line"
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if text == "" {
+			continue
+		}
+		if *inputFormat == "lines" {
+			v.validate(line, input.PasswordInput{Password: text})
+			continue
+		}
+		var i input.PasswordInput
+		if err := json.Unmarshal([]byte(text), &i); err != nil {
+			v.report(validateResult{Line: line, Error: fmt.Sprintf("invalid JSON: %s", err.Error())})
+			continue
+		}
+		v.validate(line, i)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "error reading input: %v\n", err)
+		return exitUsage
+	}
+	return v.finish()
+}
+
+type validator struct {
+	ctx                     context.Context
+	evaluatePasswordUseCase usecase.EvaluatePasswordUseCase
+	policy                  string
+	jsonOutput              bool
+	label                   string
+	out                     io.Writer
+	checked                 int
+	failed                  int
+}
+
+func (v *validator) validate(line int, i input.PasswordInput) {
+	if i.Policy == "" {
+		i.Policy = v.policy
+	}
+	result := validateResult{Line: line}
+	out, err := v.evaluatePasswordUseCase.Execute(v.ctx, i)
+	result.PasswordOutput = out
+	var invalidField _errors.InvalidField
+	if err != nil && !errors.As(err, &invalidField) {
+		result.Error = err.Error()
+	}
+	v.report(result)
+}
+
+func (v *validator) report(result validateResult) {
+	v.checked++
+	if !result.IsValid {
+		v.failed++
+	}
+
+	if v.jsonOutput {
+		json.NewEncoder(v.out).Encode(result)
+		return
+	}
+	switch {
+	case result.Error != "":
+		fmt.Fprintf(v.out, "%s %d: error: %s\n", v.label, result.Line, result.Error)
+	case result.IsValid:
+		fmt.Fprintf(v.out, "%s %d: valid (policy %s)\n", v.label, result.Line, result.Policy)
+	default:
+		fmt.Fprintf(v.out, "%s %d: invalid (policy %s)\n", v.label, result.Line, result.Policy)
+		for _, violation := range result.Violations {
+			fmt.Fprintf(v.out, "  - %s: %s\n", violation.Code, violation.Message)
+		}
+	}
+}
+
+func (v *validator) finish() int {
+	if !v.jsonOutput {
+		fmt.Fprintf(v.out, "%d checked, %d invalid\n", v.checked, v.failed)
+	}
+	if v.failed > 0 {
+		return exitInvalid
+	}
+	return exitOK
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunValidate(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "policies.json")
+	err := os.WriteFile(policyFile, []byte(`{"policies":[{"name":"relaxed","minLength":4,"requireLower":true,"allowRepeated":true}]}`), 0o600)
+	assert.NoError(t, err)
+	blocklistFile := filepath.Join(t.TempDir(), "blocklist.txt")
+	err = os.WriteFile(blocklistFile, []byte("# brand\nAcme\n"), 0o600)
+	assert.NoError(t, err)
+
+	tt := []struct {
+		name         string
+		args         []string
+		stdin        string
+		expectedCode int
+		expectedOut  string
+	}{
+		{
+			name:         "valid argument",
+			args:         []string{"validate", "AbTp9!fok"},
+			expectedCode: exitOK,
+			expectedOut:  "arg 1: valid (policy default)\n1 checked, 0 invalid\n",
+		},
+		{
+			name:         "full violation list from stdin",
+			args:         []string{"validate"},
+			stdin:        "AbTp9!fok\n\nAbTp9!foA\n",
+			expectedCode: exitInvalid,
+			expectedOut: "line 1: valid (policy default)\n" +
+				"line 3: invalid (policy default)\n" +
+				"  - unique_characters: Must not contain repeated characters (excluding spaces)\n" +
+				"2 checked, 1 invalid\n",
+		},
+		{
+			name:         "messages in another language",
+			args:         []string{"validate", "-lang", "pt-BR", "AbTp9!foA"},
+			expectedCode: exitInvalid,
+			expectedOut: "arg 1: invalid (policy default)\n" +
+				"  - unique_characters: Não deve conter caracteres repetidos (sem contar espaços)\n" +
+				"1 checked, 1 invalid\n",
+		},
+		{
+			name:         "jsonl input with json output",
+			args:         []string{"validate", "-input", "jsonl", "-format", "json"},
+			stdin:        "{\"password\":\"AbTp9!fok\"}\nerror\n",
+			expectedCode: exitInvalid,
+			expectedOut: "{\"line\":1,\"isValid\":true,\"policy\":\"default\",\"policyVersion\":\"fa38b7e8dac9\",\"satisfied\":[\"min_length\",\"unique_characters\",\"digit\",\"lowercase\",\"uppercase\",\"special_character\"],\"evaluated\":{\"whitespace\":\"strip\",\"length\":9}}\n" +
+				"{\"line\":2,\"isValid\":false,\"error\":\"invalid JSON: invalid character 'e' looking for beginning of value\"}\n",
+		},
+		{
+			name:         "policy file",
+			args:         []string{"validate", "-policy-file", policyFile, "aaaa"},
+			expectedCode: exitOK,
+			expectedOut:  "arg 1: valid (policy relaxed)\n1 checked, 0 invalid\n",
+		},
+		{
+			name:         "blocklist file",
+			args:         []string{"validate", "-blocklist-file", blocklistFile, "@cme1957!Xy"},
+			expectedCode: exitInvalid,
+			expectedOut: "arg 1: invalid (policy default)\n" +
+				"  - blocklisted_term: Must not contain names or terms related to the organization\n" +
+				"1 checked, 1 invalid\n",
+		},
+		{
+			name:         "blocklist confusables",
+			args:         []string{"validate", "-blocklist-file", blocklistFile, "-blocklist-confusables", "Асме1957!Xy"},
+			expectedCode: exitInvalid,
+			expectedOut: "arg 1: invalid (policy default)\n" +
+				"  - blocklisted_term: Must not contain names or terms related to the organization\n" +
+				"1 checked, 1 invalid\n",
+		},
+		{
+			name:         "unknown policy",
+			args:         []string{"validate", "-policy", "missing", "aaaa"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "unknown command",
+			args:         []string{"check"},
+			expectedCode: exitUsage,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(test.args, strings.NewReader(test.stdin), &stdout, &stderr)
+
+			assert.Equal(t, test.expectedCode, code)
+			assert.Equal(t, test.expectedOut, stdout.String())
+		})
+	}
+}
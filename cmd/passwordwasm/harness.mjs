@@ -0,0 +1,53 @@
+// Runs the WebAssembly build against the Go test vectors in
+// pkg/passwordpolicy/testdata/vectors.json.
+//
+//   GOOS=js GOARCH=wasm go build -o passwordvalidator.wasm ./cmd/passwordwasm
+//   node cmd/passwordwasm/harness.mjs passwordvalidator.wasm
+//
+// wasm_exec.js is taken from the Go installation; set WASM_EXEC to use another one,
+// such as the one shipped with TinyGo.
+import { execFileSync } from "node:child_process";
+import { readFileSync } from "node:fs";
+import { createRequire } from "node:module";
+import { dirname, join } from "node:path";
+import { fileURLToPath } from "node:url";
+import { deepStrictEqual, strictEqual } from "node:assert";
+
+const here = dirname(fileURLToPath(import.meta.url));
+const wasmPath = process.argv[2] ?? "passwordvalidator.wasm";
+const wasmExec =
+  process.env.WASM_EXEC ??
+  join(execFileSync("go", ["env", "GOROOT"]).toString().trim(), "lib", "wasm", "wasm_exec.js");
+
+createRequire(import.meta.url)(wasmExec);
+
+const go = new globalThis.Go();
+const { instance } = await WebAssembly.instantiate(readFileSync(wasmPath), go.importObject);
+go.run(instance);
+
+const { policies, vectors } = JSON.parse(
+  readFileSync(join(here, "..", "..", "pkg", "passwordpolicy", "testdata", "vectors.json"), "utf8"),
+);
+const validator = globalThis.passwordValidator;
+
+const loaded = validator.loadPolicies(JSON.stringify(policies));
+strictEqual(loaded.error, undefined, `loadPolicies: ${loaded.error}`);
+strictEqual(validator.validate("AbTp9!fok", "missing").error, "Policy not found with ID 'missing'");
+
+let failures = 0;
+for (const v of vectors) {
+  try {
+    const result = validator.validate(v.password, v.policy ?? "");
+    strictEqual(result.error, undefined, result.error);
+    strictEqual(result.isValid, v.isValid);
+    deepStrictEqual(result.violations.map((violation) => violation.code), v.violations);
+    deepStrictEqual(result.satisfied, v.satisfied);
+    strictEqual(validator.strength(v.password).label, v.strength);
+  } catch (err) {
+    failures++;
+    console.error(`FAIL ${JSON.stringify(v.password)}: ${err.message}`);
+  }
+}
+
+console.log(`${vectors.length - failures}/${vectors.length} vectors passed`);
+process.exit(failures === 0 ? 0 : 1);
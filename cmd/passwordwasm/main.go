@@ -0,0 +1,105 @@
+//go:build js && wasm
+
+// Command passwordwasm exposes password validation and strength estimation to
+// JavaScript, with the same rules the server applies. Build it with
+//
+//	GOOS=js GOARCH=wasm go build -o passwordvalidator.wasm ./cmd/passwordwasm
+//
+// or, for a smaller module, with tinygo build -target wasm. Once the module runs it
+// registers a global passwordValidator object:
+//
+//	passwordValidator.loadPolicies(documentJSON) // {default, policies} or {error}
+//	passwordValidator.validate(password, policy) // {isValid, policy, policyVersion, violations, satisfied} or {error}
+//	passwordValidator.strength(password)         // {score, entropy, label}
+//
+// Until loadPolicies is called the default policy is used.
+package main
+
+import (
+	"password-validator/pkg/passwordpolicy"
+	"syscall/js"
+)
+
+var document = passwordpolicy.Document{
+	Default:  passwordpolicy.DefaultName,
+	Policies: []passwordpolicy.Policy{passwordpolicy.Default()},
+}
+
+func main() {
+	js.Global().Set("passwordValidator", js.ValueOf(map[string]interface{}{
+		"loadPolicies": js.FuncOf(loadPolicies),
+		"validate":     js.FuncOf(validate),
+		"strength":     js.FuncOf(strength),
+	}))
+	select {}
+}
+
+func loadPolicies(_ js.Value, args []js.Value) interface{} {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return errorResult("loadPolicies expects the policy document as a JSON string")
+	}
+	parsed, err := passwordpolicy.Parse([]byte(args[0].String()))
+	if err != nil {
+		return errorResult(err.Error())
+	}
+	document = parsed
+
+	names := make([]interface{}, 0, len(document.Policies))
+	for _, p := range document.Policies {
+		names = append(names, p.Name)
+	}
+	return map[string]interface{}{
+		"default":  document.Default,
+		"policies": names,
+	}
+}
+
+func validate(_ js.Value, args []js.Value) interface{} {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return errorResult("validate expects the password as a string")
+	}
+	name := ""
+	if len(args) > 1 && args[1].Type() == js.TypeString {
+		name = args[1].String()
+	}
+	p, err := document.Find(name)
+	if err != nil {
+		return errorResult(err.Error())
+	}
+
+	result := passwordpolicy.Validate(args[0].String(), p)
+	violations := make([]interface{}, 0, len(result.Violations))
+	for _, v := range result.Violations {
+		violations = append(violations, map[string]interface{}{
+			"code":    v.Code,
+			"message": v.Message,
+		})
+	}
+	satisfied := make([]interface{}, 0, len(result.Satisfied))
+	for _, code := range result.Satisfied {
+		satisfied = append(satisfied, code)
+	}
+	return map[string]interface{}{
+		"isValid":       result.Valid,
+		"policy":        result.Policy,
+		"policyVersion": result.PolicyVersion,
+		"violations":    violations,
+		"satisfied":     satisfied,
+	}
+}
+
+func strength(_ js.Value, args []js.Value) interface{} {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return errorResult("strength expects the password as a string")
+	}
+	s := passwordpolicy.EstimateStrength(args[0].String())
+	return map[string]interface{}{
+		"score":   s.Score,
+		"entropy": s.Entropy,
+		"label":   s.Label,
+	}
+}
+
+func errorResult(message string) map[string]interface{} {
+	return map[string]interface{}{"error": message}
+}
@@ -0,0 +1,193 @@
+package blocklist
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	_errors "password-validator/core/errors"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultSubstringLength is the shortest term matched anywhere in a password. Shorter
+// terms only match a password made of the term alone, so "rio" rejects "R10" but not
+// "Period".
+const DefaultSubstringLength = 4
+
+// leet folds look-alike characters onto one letter. Letters that digits commonly
+// stand for are folded too ("l" and "1" both become "i"), so the password and the
+// term compare equal whichever one was substituted.
+var leet = map[rune]rune{
+	'@': 'a',
+	'4': 'a',
+	'8': 'b',
+	'(': 'c',
+	'3': 'e',
+	'9': 'g',
+	'1': 'i',
+	'!': 'i',
+	'|': 'i',
+	'l': 'i',
+	'0': 'o',
+	'$': 's',
+	'5': 's',
+	'7': 't',
+	'+': 't',
+}
+
+// confusables folds Cyrillic and Greek letters that render like Latin ones, so
+// "Асме" typed with Cyrillic letters still matches "acme". Only lowercase forms are
+// listed since matching lowercases first.
+var confusables = map[rune]rune{
+	'а': 'a', // Cyrillic
+	'в': 'b',
+	'с': 'c',
+	'ԁ': 'd',
+	'е': 'e',
+	'һ': 'h',
+	'н': 'h',
+	'і': 'i',
+	'ј': 'j',
+	'к': 'k',
+	'м': 'm',
+	'о': 'o',
+	'р': 'p',
+	'ѕ': 's',
+	'т': 't',
+	'у': 'y',
+	'х': 'x',
+	'α': 'a', // Greek
+	'β': 'b',
+	'ε': 'e',
+	'η': 'n',
+	'ι': 'i',
+	'κ': 'k',
+	'ν': 'v',
+	'ο': 'o',
+	'ρ': 'p',
+	'τ': 't',
+	'υ': 'u',
+	'χ': 'x',
+	'ı': 'i', // Latin dotless i
+	'ɡ': 'g', // Latin script g
+}
+
+// Blocklist is an organization term list: company and product names, places, teams.
+// A password matching any term is rejected.
+type Blocklist struct {
+	terms           []string
+	substringLength int
+	confusables     bool
+}
+
+func New(terms []string, substringLength int) Blocklist {
+	b := Blocklist{substringLength: substringLength}
+	for _, term := range terms {
+		if normalized := Normalize(term); normalized != "" {
+			b.terms = append(b.terms, normalized)
+		}
+	}
+	return b
+}
+
+func LoadFile(path string, substringLength int) (Blocklist, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Blocklist{}, err
+	}
+	return Parse(data, substringLength)
+}
+
+// Parse reads one term per line. Blank lines and lines starting with "#" are
+// ignored.
+func Parse(data []byte, substringLength int) (Blocklist, error) {
+	if substringLength < 1 {
+		return Blocklist{}, _errors.InvalidField{Field: "substringLength", AsIs: "Must be positive"}
+	}
+
+	var terms []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for scanner.Scan() {
+		line++
+		term := strings.TrimSpace(scanner.Text())
+		if term == "" || strings.HasPrefix(term, "#") {
+			continue
+		}
+		if Normalize(term) == "" {
+			return Blocklist{}, _errors.InvalidField{Field: fmt.Sprintf("line %d", line), AsIs: "Must contain a letter or digit"}
+		}
+		terms = append(terms, term)
+	}
+	if err := scanner.Err(); err != nil {
+		return Blocklist{}, fmt.Errorf("error reading blocklist: %w", err)
+	}
+	return New(terms, substringLength), nil
+}
+
+// WithConfusables makes the list also match passwords that spell a term with
+// homoglyphs ("Асме" in Cyrillic) or accented letters ("Ácmé").
+func (b Blocklist) WithConfusables() Blocklist {
+	folded := Blocklist{substringLength: b.substringLength, confusables: true}
+	for _, term := range b.terms {
+		folded.terms = append(folded.terms, Fold(term))
+	}
+	return folded
+}
+
+// Normalize applies NFKC, lowercases, folds l33t-speak substitutions and drops
+// everything but letters and digits, so "P@ssw0rd", "pass-word" and the full-width
+// "Ｐａｓｓｗｏｒｄ" all become "password".
+func Normalize(s string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(norm.NFKC.String(s)) {
+		if folded, ok := leet[c]; ok {
+			c = folded
+		}
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// Fold maps homoglyphs onto the Latin letters they render like and strips
+// diacritics from a normalized string.
+func Fold(s string) string {
+	var b strings.Builder
+	for _, c := range norm.NFD.String(s) {
+		if unicode.Is(unicode.Mn, c) {
+			continue
+		}
+		if folded, ok := confusables[c]; ok {
+			c = folded
+		}
+		b.WriteRune(c)
+	}
+	return norm.NFC.String(b.String())
+}
+
+// Match reports whether the password is made of a term, or contains one at least
+// substringLength characters long.
+func (b Blocklist) Match(password string) bool {
+	normalized := Normalize(password)
+	if b.confusables {
+		normalized = Fold(normalized)
+	}
+	for _, term := range b.terms {
+		if normalized == term {
+			return true
+		}
+		if len([]rune(term)) >= b.substringLength && strings.Contains(normalized, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b Blocklist) Len() int {
+	return len(b.terms)
+}
@@ -0,0 +1,116 @@
+package blocklist
+
+import (
+	_errors "password-validator/core/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tt := []struct {
+		input  string
+		output string
+	}{
+		{input: "P@ssw0rd", output: "password"},
+		{input: "pass-word", output: "password"},
+		{input: "ACME Corp", output: "acmecorp"},
+		{input: "G10b4l", output: "giobai"},
+		{input: "Global", output: "giobai"},
+		{input: "$ã0 P4ul0", output: "sãopauio"},
+		{input: "ＡＣＭＥ", output: "acme"},
+		{input: "Sa\u0303o", output: "são"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.output, Normalize(test.input))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	b := New([]string{"Acme", "Rio", "Flamengo"}, DefaultSubstringLength)
+	tt := []struct {
+		name     string
+		password string
+		output   bool
+	}{
+		{name: "term", password: "acme", output: true},
+		{name: "case insensitive", password: "ACME", output: true},
+		{name: "l33t", password: "@cm3", output: true},
+		{name: "long term as substring", password: "Fl4meng0!2024", output: true},
+		{name: "short term alone", password: "R10", output: true},
+		{name: "short term as substring", password: "Period9!", output: false},
+		{name: "unrelated", password: "AbTp9!fok", output: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.output, b.Match(test.password))
+		})
+	}
+}
+
+func TestMatchConfusables(t *testing.T) {
+	plain := New([]string{"Acme", "São Paulo"}, DefaultSubstringLength)
+	folded := plain.WithConfusables()
+	tt := []struct {
+		name     string
+		password string
+		plain    bool
+		folded   bool
+	}{
+		{name: "full-width", password: "ＡＣＭＥ2024!", plain: true, folded: true},
+		{name: "cyrillic homoglyphs", password: "Асме1957!", plain: false, folded: true},
+		{name: "greek homoglyphs", password: "Αcmε1957!", plain: false, folded: true},
+		{name: "accented letters", password: "Ácmé1957!", plain: false, folded: true},
+		{name: "accents dropped from the term", password: "SaoPaulo!1", plain: false, folded: true},
+		{name: "unrelated", password: "AbTp9!fok", plain: false, folded: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.plain, plain.Match(test.password))
+			assert.Equal(t, test.folded, folded.Match(test.password))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tt := []struct {
+		name            string
+		input           string
+		substringLength int
+		output          Blocklist
+		expectedErr     error
+	}{
+		{
+			name:            "terms, comments and blank lines",
+			input:           "# brand\nAcme\n\n  Acme Pay  \n",
+			substringLength: 4,
+			output:          Blocklist{terms: []string{"acme", "acmepay"}, substringLength: 4},
+		},
+		{
+			name:            "term without letters or digits",
+			input:           "acme\n--\n",
+			substringLength: 4,
+			expectedErr:     _errors.InvalidField{Field: "line 2", AsIs: "Must contain a letter or digit"},
+		},
+		{
+			name:            "substring length",
+			input:           "acme\n",
+			substringLength: 0,
+			expectedErr:     _errors.InvalidField{Field: "substringLength", AsIs: "Must be positive"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := Parse([]byte(test.input), test.substringLength)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.output, b)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// Package compliance assesses policies against the password guidance of common
+// security standards.
+package compliance
+
+import (
+	"fmt"
+	"password-validator/core/domain/policy"
+	"strings"
+)
+
+const (
+	NIST = "NIST SP 800-63B"
+	PCI  = "PCI DSS 4.0"
+	ASVS = "OWASP ASVS 4.0 V2"
+	ISO  = "ISO/IEC 27001:2022"
+)
+
+// Standards lists the assessed standards in report order.
+var Standards = []string{NIST, PCI, ASVS, ISO}
+
+// Finding statuses. StatusManual marks requirements outside what a policy decides,
+// such as password history or storage, that have to be verified elsewhere.
+const (
+	StatusMet    = "met"
+	StatusGap    = "gap"
+	StatusManual = "manual"
+)
+
+type (
+	Finding struct {
+		Standard    string
+		Reference   string
+		Requirement string
+		Status      string
+		Detail      string
+	}
+
+	// Facts are what a policy is assessed on: the policy itself and whether an
+	// organization blocklist is applied along with it.
+	Facts struct {
+		Policy    policy.Policy
+		Blocklist bool
+	}
+)
+
+// Assess returns the findings for every standard, in the order of Standards.
+func Assess(f Facts) []Finding {
+	var findings []Finding
+	findings = append(findings, nist(f)...)
+	findings = append(findings, pci(f)...)
+	findings = append(findings, asvs(f)...)
+	return append(findings, iso(f)...)
+}
+
+func nist(f Facts) []Finding {
+	p := f.Policy
+	return []Finding{
+		atLeast(NIST, "5.1.1.2", "Passwords have at least 8 characters", p.MinLength, 8),
+		maximumLength(NIST, "5.1.1.2", p),
+		spaces(NIST, "5.1.1.2", "Printing ASCII characters, spaces and Unicode are accepted", p),
+		noComposition(NIST, "5.1.1.2", p),
+		commonPasswords(NIST, "5.1.1.2", "Passwords are compared against commonly used, expected or compromised values", p),
+		contextWords(NIST, "5.1.1.2", "Context-specific words, such as the name of the service, are rejected", f.Blocklist),
+		repetitive(NIST, "5.1.1.2", "Repetitive characters, such as 'aaaaaa', are rejected", p),
+		{Standard: NIST, Reference: "5.1.1.2", Requirement: "Passwords are not expired periodically", Status: StatusMet, Detail: "policies have no expiration"},
+		manual(NIST, "5.2.2", "Failed authentication attempts are rate limited"),
+		manual(NIST, "5.1.1.2", "Passwords are stored salted and hashed with a memory-hard or iterated function"),
+	}
+}
+
+func pci(f Facts) []Finding {
+	p := f.Policy
+	length := atLeast(PCI, "8.3.6", "Passwords have at least 12 characters", p.MinLength, 12)
+	if length.Status == StatusGap && p.MinLength >= 8 {
+		length.Detail += "; 8 is only allowed where the system does not support 12"
+	}
+	composition := Finding{Standard: PCI, Reference: "8.3.6", Requirement: "Passwords contain both numeric and alphabetic characters", Status: StatusMet}
+	if !requiresDigit(p) || !requiresLetter(p) {
+		composition.Status = StatusGap
+		composition.Detail = fmt.Sprintf("digits %s, letters %s", requirement(requiresDigit(p)), requirement(requiresLetter(p)))
+	}
+	return []Finding{
+		length,
+		composition,
+		manual(PCI, "8.3.7", "New passwords differ from the last four used"),
+		manual(PCI, "8.3.9", "Passwords are changed every 90 days, or access is analyzed dynamically"),
+	}
+}
+
+func asvs(f Facts) []Finding {
+	p := f.Policy
+	return []Finding{
+		atLeast(ASVS, "2.1.1", "Passwords have at least 12 characters", p.MinLength, 12),
+		maximumLength(ASVS, "2.1.2", p),
+		{Standard: ASVS, Reference: "2.1.3", Requirement: "Passwords are not truncated", Status: StatusMet, Detail: "longer passwords are rejected, never truncated"},
+		spaces(ASVS, "2.1.4", "Any printable Unicode character, spaces and emoji included, is accepted", p),
+		commonPasswords(ASVS, "2.1.7", "Passwords are checked against a set of breached passwords", p),
+		{Standard: ASVS, Reference: "2.1.8", Requirement: "A password strength meter is provided", Status: StatusMet, Detail: "strength estimates and live feedback are available"},
+		noComposition(ASVS, "2.1.9", p),
+		{Standard: ASVS, Reference: "2.1.10", Requirement: "There are no periodic rotation or history requirements", Status: StatusMet, Detail: "policies have neither"},
+	}
+}
+
+// iso follows the ISO/IEC 27002:2022 guidance for control 5.17 of ISO/IEC 27001,
+// which leaves the minimum length to the organization.
+func iso(f Facts) []Finding {
+	p := f.Policy
+	length := Finding{Standard: ISO, Reference: "A.5.17", Requirement: "A minimum length is enforced", Status: StatusMet, Detail: fmt.Sprintf("minLength is %d", p.MinLength)}
+	if p.MinLength == 0 {
+		length.Status = StatusGap
+	}
+	mixed := Finding{Standard: ISO, Reference: "A.5.17", Requirement: "Passwords are not all numeric or all alphabetic", Status: StatusMet}
+	if !requiresSpecial(p) && p.MinClasses < 2 && (!requiresDigit(p) || !requiresLetter(p)) {
+		mixed.Status = StatusGap
+		mixed.Detail = "no combination of character classes is required"
+	}
+	return []Finding{
+		length,
+		commonPasswords(ISO, "A.5.17", "Passwords are not vulnerable to dictionary attacks", p),
+		contextWords(ISO, "A.5.17", "Passwords are not based on information easily guessed, such as names", f.Blocklist),
+		repetitive(ISO, "A.5.17", "Passwords are free of consecutive identical characters", p),
+		mixed,
+	}
+}
+
+func atLeast(standard, reference, requirement string, minLength, expected int) Finding {
+	f := Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusMet, Detail: fmt.Sprintf("minLength is %d", minLength)}
+	if minLength < expected {
+		f.Status = StatusGap
+	}
+	return f
+}
+
+func maximumLength(standard, reference string, p policy.Policy) Finding {
+	f := Finding{Standard: standard, Reference: reference, Requirement: "Passwords of at least 64 characters are accepted", Status: StatusMet, Detail: "no maxLength"}
+	if p.MaxLength > 0 {
+		f.Detail = fmt.Sprintf("maxLength is %d", p.MaxLength)
+		if p.MaxLength < 64 {
+			f.Status = StatusGap
+		}
+	}
+	return f
+}
+
+func spaces(standard, reference, requirement string, p policy.Policy) Finding {
+	f := Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusMet, Detail: fmt.Sprintf("whitespace is %s", p.WhitespaceMode())}
+	if p.WhitespaceMode() == policy.WhitespaceReject {
+		f.Status = StatusGap
+	}
+	return f
+}
+
+// noComposition reports the composition rules: the required character classes and
+// the ban on repeated characters. Limits on consecutive characters are left out,
+// since the standards recommend rejecting repetitive passwords.
+func noComposition(standard, reference string, p policy.Policy) Finding {
+	var rules []string
+	for _, rule := range []struct {
+		name    string
+		applied bool
+	}{
+		{"digits", p.RequireDigit || p.MinDigits > 0},
+		{"lowercase letters", p.RequireLower || p.MinLower > 0},
+		{"uppercase letters", p.RequireUpper || p.MinUpper > 0},
+		{"special characters", requiresSpecial(p)},
+		{fmt.Sprintf("%d character classes", p.MinClasses), p.MinClasses > 0},
+		{"no repeated characters", !p.AllowRepeated},
+		{fmt.Sprintf("%d different characters", p.MinUnique), p.MinUnique > 0},
+	} {
+		if rule.applied {
+			rules = append(rules, rule.name)
+		}
+	}
+	f := Finding{Standard: standard, Reference: reference, Requirement: "No composition rules are imposed", Status: StatusMet}
+	if len(rules) > 0 {
+		f.Status = StatusGap
+		f.Detail = fmt.Sprintf("composition rules present: %s", strings.Join(rules, ", "))
+	}
+	return f
+}
+
+func commonPasswords(standard, reference, requirement string, p policy.Policy) Finding {
+	if p.RejectCommon {
+		return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusMet, Detail: "rejectCommon is enabled"}
+	}
+	return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusGap, Detail: "rejectCommon is disabled"}
+}
+
+func contextWords(standard, reference, requirement string, blocklist bool) Finding {
+	if blocklist {
+		return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusMet, Detail: "an organization blocklist is applied"}
+	}
+	return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusGap, Detail: "no organization blocklist"}
+}
+
+func repetitive(standard, reference, requirement string, p policy.Policy) Finding {
+	switch {
+	case !p.AllowRepeated:
+		return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusMet, Detail: "repeated characters are rejected"}
+	case p.MaxConsecutive > 0:
+		return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusMet, Detail: fmt.Sprintf("maxConsecutive is %d", p.MaxConsecutive)}
+	}
+	return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusGap, Detail: "no maxConsecutive"}
+}
+
+func manual(standard, reference, requirement string) Finding {
+	return Finding{Standard: standard, Reference: reference, Requirement: requirement, Status: StatusManual, Detail: "outside the password policy"}
+}
+
+func requiresDigit(p policy.Policy) bool {
+	return p.RequireDigit || p.MinDigits > 0 || p.MinClasses == 4
+}
+
+// requiresLetter is true when a letter is always required: three of the four
+// classes always include a lowercase or an uppercase one.
+func requiresLetter(p policy.Policy) bool {
+	return p.RequireLower || p.RequireUpper || p.MinLower > 0 || p.MinUpper > 0 || p.MinClasses >= 3
+}
+
+func requiresSpecial(p policy.Policy) bool {
+	return p.RequireSpecial || p.MinSpecial > 0
+}
+
+func requirement(required bool) string {
+	if required {
+		return "required"
+	}
+	return "not required"
+}
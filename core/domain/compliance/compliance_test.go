@@ -0,0 +1,123 @@
+package compliance
+
+import (
+	"password-validator/core/domain/policy"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssess(t *testing.T) {
+	passphrase := policy.Policy{Name: "passphrase", MinLength: 16, AllowRepeated: true, MaxConsecutive: 3, RejectCommon: true}
+	tt := []struct {
+		name           string
+		facts          Facts
+		standard       string
+		requirement    string
+		expectedStatus string
+		expectedDetail string
+	}{
+		{
+			name:           "composition rules are contrary to NIST",
+			facts:          Facts{Policy: policy.Default()},
+			standard:       NIST,
+			requirement:    "No composition rules are imposed",
+			expectedStatus: StatusGap,
+			expectedDetail: "composition rules present: digits, lowercase letters, uppercase letters, special characters, no repeated characters",
+		},
+		{
+			name:           "passphrase has no composition rules",
+			facts:          Facts{Policy: passphrase},
+			standard:       ASVS,
+			requirement:    "No composition rules are imposed",
+			expectedStatus: StatusMet,
+		},
+		{
+			name:           "PCI minimum length",
+			facts:          Facts{Policy: policy.Default()},
+			standard:       PCI,
+			requirement:    "Passwords have at least 12 characters",
+			expectedStatus: StatusGap,
+			expectedDetail: "minLength is 9; 8 is only allowed where the system does not support 12",
+		},
+		{
+			name:           "PCI numeric and alphabetic from four classes",
+			facts:          Facts{Policy: policy.Policy{Name: "classes", MinLength: 12, MinClasses: 4}},
+			standard:       PCI,
+			requirement:    "Passwords contain both numeric and alphabetic characters",
+			expectedStatus: StatusMet,
+		},
+		{
+			name:           "PCI numeric not required by three classes",
+			facts:          Facts{Policy: policy.Policy{Name: "classes", MinLength: 12, MinClasses: 3}},
+			standard:       PCI,
+			requirement:    "Passwords contain both numeric and alphabetic characters",
+			expectedStatus: StatusGap,
+			expectedDetail: "digits not required, letters required",
+		},
+		{
+			name:           "short maximum length",
+			facts:          Facts{Policy: policy.Policy{Name: "short", MinLength: 8, MaxLength: 32}},
+			standard:       ASVS,
+			requirement:    "Passwords of at least 64 characters are accepted",
+			expectedStatus: StatusGap,
+			expectedDetail: "maxLength is 32",
+		},
+		{
+			name:           "spaces rejected",
+			facts:          Facts{Policy: policy.Policy{Name: "no-spaces", Whitespace: policy.WhitespaceReject}},
+			standard:       NIST,
+			requirement:    "Printing ASCII characters, spaces and Unicode are accepted",
+			expectedStatus: StatusGap,
+			expectedDetail: "whitespace is reject",
+		},
+		{
+			name:           "blocklist rejects context-specific words",
+			facts:          Facts{Policy: passphrase, Blocklist: true},
+			standard:       ISO,
+			requirement:    "Passwords are not based on information easily guessed, such as names",
+			expectedStatus: StatusMet,
+			expectedDetail: "an organization blocklist is applied",
+		},
+		{
+			name:           "consecutive characters limited",
+			facts:          Facts{Policy: passphrase},
+			standard:       ISO,
+			requirement:    "Passwords are free of consecutive identical characters",
+			expectedStatus: StatusMet,
+			expectedDetail: "maxConsecutive is 3",
+		},
+		{
+			name:           "all alphabetic passphrases allowed",
+			facts:          Facts{Policy: passphrase},
+			standard:       ISO,
+			requirement:    "Passwords are not all numeric or all alphabetic",
+			expectedStatus: StatusGap,
+			expectedDetail: "no combination of character classes is required",
+		},
+		{
+			name:           "history verified elsewhere",
+			facts:          Facts{Policy: passphrase},
+			standard:       PCI,
+			requirement:    "New passwords differ from the last four used",
+			expectedStatus: StatusManual,
+			expectedDetail: "outside the password policy",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var found *Finding
+			for _, f := range Assess(test.facts) {
+				if f.Standard == test.standard && f.Requirement == test.requirement {
+					found = &f
+				}
+			}
+
+			if assert.NotNil(t, found) {
+				assert.Equal(t, test.expectedStatus, found.Status)
+				assert.Equal(t, test.expectedDetail, found.Detail)
+			}
+		})
+	}
+}
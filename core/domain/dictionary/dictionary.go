@@ -0,0 +1,83 @@
+package dictionary
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// minWordLength keeps stripping from reducing a password to a short, common-looking
+// stem: "Xyz1!" is not "xyz".
+const minWordLength = 4
+
+//go:embed common_passwords.txt
+var commonPasswords []byte
+
+var common = sync.OnceValue(func() Dictionary {
+	return Parse(commonPasswords)
+})
+
+type (
+	// Dictionary ranks passwords by how common they are, 1 being the most common.
+	Dictionary struct {
+		ranks map[string]int
+	}
+
+	// Match is a password found in a dictionary. Suffix holds the trailing digits and
+	// symbols stripped from the password to find Word, empty for an exact match.
+	Match struct {
+		Word   string
+		Rank   int
+		Suffix string
+	}
+)
+
+// Common returns the embedded list of the 7141 most common passwords of Mark
+// Burnett's list, as ranked by zxcvbn.
+func Common() Dictionary {
+	return common()
+}
+
+// Parse reads one password per line, most common first. Blank lines and lines
+// starting with "#" are ignored.
+func Parse(data []byte) Dictionary {
+	d := Dictionary{ranks: make(map[string]int)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		word := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		if _, ok := d.ranks[word]; !ok {
+			d.ranks[word] = len(d.ranks) + 1
+		}
+	}
+	return d
+}
+
+// Lookup finds a password in the dictionary, ignoring case and compatibility forms
+// (the full-width "ｑｗｅｒｔｙ" is "qwerty"). Trailing digits and
+// symbols, which users append to satisfy composition rules, are stripped one at a
+// time until a word matches, so "Welcome1!" matches "welcome1" and then "welcome".
+func (d Dictionary) Lookup(password string) (Match, bool) {
+	runes := []rune(strings.ToLower(norm.NFKC.String(password)))
+	for end := len(runes); end >= minWordLength; end-- {
+		if end < len(runes) && unicode.IsLetter(runes[end]) {
+			break
+		}
+		word := string(runes[:end])
+		if rank, ok := d.ranks[word]; ok {
+			return Match{Word: word, Rank: rank, Suffix: string(runes[end:])}, true
+		}
+	}
+	return Match{}, false
+}
+
+func (d Dictionary) Len() int {
+	return len(d.ranks)
+}
@@ -0,0 +1,56 @@
+package dictionary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	d := Parse([]byte("# ranked\npassword\nwelcome\nwelcome1\n\nPassword\n"))
+	tt := []struct {
+		name     string
+		password string
+		output   Match
+		found    bool
+	}{
+		{name: "exact", password: "password", output: Match{Word: "password", Rank: 1}, found: true},
+		{name: "case insensitive", password: "PassWord", output: Match{Word: "password", Rank: 1}, found: true},
+		{name: "full-width", password: "ＰＡＳＳＷＯＲＤ", output: Match{Word: "password", Rank: 1}, found: true},
+		{name: "longest word first", password: "Welcome1!", output: Match{Word: "welcome1", Rank: 3, Suffix: "!"}, found: true},
+		{name: "appended digits and symbols", password: "Welcome2024!#", output: Match{Word: "welcome", Rank: 2, Suffix: "2024!#"}, found: true},
+		{name: "letters after the word", password: "Welcomehome1!", found: false},
+		{name: "prefixed word", password: "1Welcome", found: false},
+		{name: "unrelated", password: "AbTp9!fok", found: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			m, found := d.Lookup(test.password)
+
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.output, m)
+		})
+	}
+}
+
+func TestLookupShortWords(t *testing.T) {
+	d := Parse([]byte("abc\nabcd\n"))
+
+	_, found := d.Lookup("abc!!!")
+	assert.False(t, found)
+	m, found := d.Lookup("abcd!!!")
+	assert.True(t, found)
+	assert.Equal(t, "abcd", m.Word)
+}
+
+func TestCommon(t *testing.T) {
+	d := Common()
+
+	assert.Equal(t, 7141, d.Len())
+	m, found := d.Lookup("password")
+	assert.True(t, found)
+	assert.Equal(t, 1, m.Rank)
+	_, found = d.Lookup("Welcome1!")
+	assert.True(t, found)
+}
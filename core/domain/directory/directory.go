@@ -0,0 +1,216 @@
+// Package directory maps the password policies of Active Directory and LDAP servers,
+// exported as LDIF, onto policy documents.
+package directory
+
+import (
+	"encoding/json"
+	"fmt"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ADSpecialChars are the characters Active Directory complexity counts as special.
+// Currency symbols and other punctuation do not count.
+const ADSpecialChars = "~!@#$%^&*_-+=`|\\(){}[]:;\"'<>,.?/"
+
+// domainPasswordComplex is the pwdProperties flag enabling complexity for a domain.
+const domainPasswordComplex = 1
+
+const (
+	kindADDomain = iota
+	kindADFineGrained
+	kindLDAP
+)
+
+type imported struct {
+	policy     policy.Policy
+	kind       int
+	precedence int
+}
+
+// Import maps every password policy in entries onto a policy of the returned
+// document, along with notes on the settings the validator cannot enforce. Entries
+// that are not password policies are ignored. The AD domain policy comes first and
+// is the default, then fine-grained policies by precedence, then LDAP policies in the
+// order they appear.
+func Import(entries []Entry) (policy.Document, []string, error) {
+	var (
+		policies []imported
+		notes    []string
+	)
+	for _, e := range entries {
+		var (
+			i   imported
+			n   []string
+			err error
+		)
+		switch {
+		case e.Has("objectClass", "msDS-PasswordSettings"):
+			i, n, err = fineGrained(e)
+		case e.Has("objectClass", "pwdPolicy"):
+			i, n, err = ppolicy(e)
+		case e.Get("minPwdLength") != "":
+			i, n, err = domain(e)
+		default:
+			continue
+		}
+		if err != nil {
+			return policy.Document{}, nil, fmt.Errorf("entry '%s': %w", e.DN, err)
+		}
+		policies = append(policies, i)
+		notes = append(notes, n...)
+	}
+	if len(policies) == 0 {
+		return policy.Document{}, nil, _errors.InvalidField{Field: "entries", AsIs: "Must contain an Active Directory or LDAP password policy"}
+	}
+
+	sort.SliceStable(policies, func(a, b int) bool {
+		if policies[a].kind != policies[b].kind {
+			return policies[a].kind < policies[b].kind
+		}
+		return policies[a].precedence < policies[b].precedence
+	})
+	d := policy.Document{Default: policies[0].policy.Name}
+	for _, i := range policies {
+		d.Policies = append(d.Policies, i.policy)
+	}
+
+	// Parsed back so the document gets the validation and defaults of a policy file.
+	data, _ := json.Marshal(d)
+	d, err := policy.Parse(data)
+	return d, notes, err
+}
+
+// domain maps the default password policy of an AD domain, read from the domain
+// object.
+func domain(e Entry) (imported, []string, error) {
+	minLength, err := integer(e, "minPwdLength")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	properties, err := integer(e, "pwdProperties")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	history, err := integer(e, "pwdHistoryLength")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	p, notes := activeDirectory(e, minLength, properties&domainPasswordComplex != 0, history)
+	return imported{policy: p, kind: kindADDomain}, notes, nil
+}
+
+// fineGrained maps an AD fine-grained password policy (a msDS-PasswordSettings
+// object).
+func fineGrained(e Entry) (imported, []string, error) {
+	minLength, err := integer(e, "msDS-MinimumPasswordLength")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	history, err := integer(e, "msDS-PasswordHistoryLength")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	precedence, err := integer(e, "msDS-PasswordSettingsPrecedence")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	p, notes := activeDirectory(e, minLength, e.Has("msDS-PasswordComplexityEnabled", "TRUE"), history)
+	return imported{policy: p, kind: kindADFineGrained, precedence: precedence}, notes, nil
+}
+
+// activeDirectory builds the policy AD enforces. Complexity requires three of the
+// character classes, letters with diacritics included, and AD counts spaces towards
+// the length and allows repeated characters.
+func activeDirectory(e Entry, minLength int, complex bool, history int) (policy.Policy, []string) {
+	p := policy.Policy{
+		Name:          name(e),
+		Description:   "Imported from " + e.DN,
+		MinLength:     minLength,
+		AllowRepeated: true,
+		Whitespace:    policy.WhitespaceCount,
+	}
+	var notes []string
+	if complex {
+		p.MinClasses = 3
+		p.SpecialChars = ADSpecialChars
+		notes = append(notes, fmt.Sprintf("%s: complexity also rejects the account and display names, and counts letters without case as a fifth class; neither is enforced", p.Name))
+	}
+	return p, append(notes, historyNote(p.Name, history)...)
+}
+
+// ppolicy maps an LDAP pwdPolicy entry of the ppolicy overlay. The server only checks
+// lengths when pwdCheckQuality is set; quality modules are not mirrored.
+func ppolicy(e Entry) (imported, []string, error) {
+	minLength, err := integer(e, "pwdMinLength")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	maxLength, err := integer(e, "pwdMaxLength")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	quality, err := integer(e, "pwdCheckQuality")
+	if err != nil {
+		return imported{}, nil, err
+	}
+	history, err := integer(e, "pwdInHistory")
+	if err != nil {
+		return imported{}, nil, err
+	}
+
+	p := policy.Policy{
+		Name:          name(e),
+		Description:   "Imported from " + e.DN,
+		AllowRepeated: true,
+		Whitespace:    policy.WhitespaceCount,
+	}
+	var notes []string
+	switch {
+	case quality > 0:
+		p.MinLength = minLength
+		p.MaxLength = maxLength
+		if minLength > 0 || maxLength > 0 {
+			notes = append(notes, fmt.Sprintf("%s: lengths are counted in characters, while the directory counts bytes of non-ASCII passwords", p.Name))
+		}
+	case minLength > 0 || maxLength > 0:
+		notes = append(notes, fmt.Sprintf("%s: pwdMinLength and pwdMaxLength are not imported, since pwdCheckQuality is 0 and the directory ignores them", p.Name))
+	}
+	if module := e.Get("pwdCheckModule"); module != "" {
+		notes = append(notes, fmt.Sprintf("%s: the checks of quality module %s are not enforced", p.Name, module))
+	}
+	notes = append(notes, historyNote(p.Name, history)...)
+	return imported{policy: p, kind: kindLDAP}, notes, nil
+}
+
+func historyNote(name string, history int) []string {
+	if history == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: a history of %d passwords is not enforced, the validator does not see previous passwords", name, history)}
+}
+
+// name is the cn of an entry, or the value of the first component of its DN.
+func name(e Entry) string {
+	if cn := e.Get("cn"); cn != "" {
+		return cn
+	}
+	first, _, _ := strings.Cut(e.DN, ",")
+	_, value, _ := strings.Cut(first, "=")
+	return strings.TrimSpace(value)
+}
+
+func integer(e Entry, attribute string) (int, error) {
+	value := e.Get(attribute)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, _errors.InvalidField{Field: attribute, AsIs: "Must be a non-negative integer"}
+	}
+	return n, nil
+}
@@ -0,0 +1,141 @@
+package directory
+
+import (
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const adExport = `version: 1
+
+# Default domain policy
+dn: DC=corp,DC=example,DC=com
+objectClass: top
+objectClass: domain
+objectClass: domainDNS
+minPwdLength: 10
+pwdProperties: 1
+pwdHistoryLength: 24
+
+dn: CN=Service Accounts,CN=Password Settings Container,CN=System,DC=corp,DC=exa
+ mple,DC=com
+objectClass: msDS-PasswordSettings
+cn: Service Accounts
+msDS-MinimumPasswordLength: 20
+msDS-PasswordComplexityEnabled: FALSE
+msDS-PasswordSettingsPrecedence: 20
+
+dn: CN=Admins,CN=Password Settings Container,CN=System,DC=corp,DC=example,DC=c
+ om
+objectClass: msDS-PasswordSettings
+cn:: QWRtaW5z
+msDS-MinimumPasswordLength: 15
+msDS-PasswordComplexityEnabled: TRUE
+msDS-PasswordSettingsPrecedence: 10
+`
+
+const ldapExport = `dn: cn=default,ou=policies,dc=example,dc=com
+objectClass: pwdPolicy
+objectClass: device
+cn: default
+pwdAttribute: userPassword
+pwdMinLength: 12
+pwdCheckQuality: 2
+pwdInHistory: 5
+
+dn: cn=legacy,ou=policies,dc=example,dc=com
+objectClass: pwdPolicy
+objectClass: pwdPolicyChecker
+cn: legacy
+pwdMinLength: 8
+pwdCheckQuality: 0
+pwdCheckModule: check_password.so
+
+dn: uid=jdoe,ou=people,dc=example,dc=com
+objectClass: person
+uid: jdoe
+`
+
+func TestParseLDIF(t *testing.T) {
+	entries, err := ParseLDIF(strings.NewReader(adExport))
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "CN=Service Accounts,CN=Password Settings Container,CN=System,DC=corp,DC=example,DC=com", entries[1].DN)
+	assert.Equal(t, "Admins", entries[2].Get("cn"))
+	assert.True(t, entries[0].Has("objectclass", "DOMAINDNS"))
+
+	_, err = ParseLDIF(strings.NewReader("dn: cn=a\ncn:< file:///etc/passwd\n"))
+	assert.EqualError(t, err, "error reading LDIF line 2: value of cn is read from a URL, which is not supported")
+}
+
+func TestImport(t *testing.T) {
+	tt := []struct {
+		name          string
+		input         string
+		output        policy.Document
+		expectedNotes []string
+		expectedErr   error
+	}{
+		{
+			name:  "active directory",
+			input: adExport,
+			output: policy.Document{
+				Default: "corp",
+				Policies: []policy.Policy{
+					{Name: "corp", Description: "Imported from DC=corp,DC=example,DC=com", MinLength: 10, AllowRepeated: true, Whitespace: policy.WhitespaceCount, MinClasses: 3, SpecialChars: ADSpecialChars},
+					{Name: "Admins", Description: "Imported from CN=Admins,CN=Password Settings Container,CN=System,DC=corp,DC=example,DC=com", MinLength: 15, AllowRepeated: true, Whitespace: policy.WhitespaceCount, MinClasses: 3, SpecialChars: ADSpecialChars},
+					{Name: "Service Accounts", Description: "Imported from CN=Service Accounts,CN=Password Settings Container,CN=System,DC=corp,DC=example,DC=com", MinLength: 20, AllowRepeated: true, Whitespace: policy.WhitespaceCount},
+				},
+			},
+			expectedNotes: []string{
+				"corp: complexity also rejects the account and display names, and counts letters without case as a fifth class; neither is enforced",
+				"corp: a history of 24 passwords is not enforced, the validator does not see previous passwords",
+				"Admins: complexity also rejects the account and display names, and counts letters without case as a fifth class; neither is enforced",
+			},
+		},
+		{
+			name:  "ldap ppolicy",
+			input: ldapExport,
+			output: policy.Document{
+				Default: "default",
+				Policies: []policy.Policy{
+					{Name: "default", Description: "Imported from cn=default,ou=policies,dc=example,dc=com", MinLength: 12, AllowRepeated: true, Whitespace: policy.WhitespaceCount},
+					{Name: "legacy", Description: "Imported from cn=legacy,ou=policies,dc=example,dc=com", AllowRepeated: true, Whitespace: policy.WhitespaceCount},
+				},
+			},
+			expectedNotes: []string{
+				"default: lengths are counted in characters, while the directory counts bytes of non-ASCII passwords",
+				"default: a history of 5 passwords is not enforced, the validator does not see previous passwords",
+				"legacy: pwdMinLength and pwdMaxLength are not imported, since pwdCheckQuality is 0 and the directory ignores them",
+				"legacy: the checks of quality module check_password.so are not enforced",
+			},
+		},
+		{
+			name:        "no policy",
+			input:       "dn: uid=jdoe,dc=example,dc=com\nobjectClass: person\n",
+			expectedErr: _errors.InvalidField{Field: "entries", AsIs: "Must contain an Active Directory or LDAP password policy"},
+		},
+		{
+			name:        "invalid length",
+			input:       "dn: cn=a,dc=example,dc=com\nobjectClass: pwdPolicy\npwdMinLength: eight\n",
+			expectedErr: _errors.InvalidField{Field: "pwdMinLength", AsIs: "Must be a non-negative integer"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			entries, err := ParseLDIF(strings.NewReader(test.input))
+			assert.NoError(t, err)
+
+			document, notes, err := Import(entries)
+
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.output, document)
+			assert.Equal(t, test.expectedNotes, notes)
+		})
+	}
+}
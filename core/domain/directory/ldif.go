@@ -0,0 +1,131 @@
+package directory
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Entry is a directory object read from an LDIF export. Attribute names are kept
+// lowercase, since LDAP compares them without case.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Get returns the first value of an attribute, or "" when the entry has none.
+func (e Entry) Get(name string) string {
+	values := e.Attributes[strings.ToLower(name)]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Has reports whether any value of an attribute equals value, ignoring case.
+func (e Entry) Has(name, value string) bool {
+	for _, v := range e.Attributes[strings.ToLower(name)] {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadFile reads the entries of the LDIF export at path, as ParseLDIF does.
+func LoadFile(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseLDIF(f)
+}
+
+// ParseLDIF reads the entries of an RFC 2849 LDIF export, as written by ldapsearch or
+// ldifde: folded lines, comments and base64 values are supported, values read from
+// URLs are not.
+func ParseLDIF(r io.Reader) ([]Entry, error) {
+	var (
+		entries []Entry
+		current *Entry
+		lines   []line
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	comment := false
+	for number := 1; scanner.Scan(); number++ {
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		switch {
+		case strings.HasPrefix(text, " ") && (comment || len(lines) > 0):
+			if !comment {
+				lines[len(lines)-1].text += text[1:]
+			}
+		case strings.HasPrefix(text, "#"):
+			comment = true
+		default:
+			comment = false
+			lines = append(lines, line{number: number, text: text})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading LDIF: %w", err)
+	}
+
+	for _, l := range lines {
+		if l.text == "" {
+			if current != nil {
+				entries = append(entries, *current)
+				current = nil
+			}
+			continue
+		}
+		name, value, err := attribute(l.text)
+		if err != nil {
+			return nil, fmt.Errorf("error reading LDIF line %d: %w", l.number, err)
+		}
+		switch {
+		case name == "dn":
+			if current != nil {
+				entries = append(entries, *current)
+			}
+			current = &Entry{DN: value, Attributes: make(map[string][]string)}
+		case current == nil:
+			// "version: 1" and other lines before the first dn.
+		default:
+			current.Attributes[name] = append(current.Attributes[name], value)
+		}
+	}
+	if current != nil {
+		entries = append(entries, *current)
+	}
+	return entries, nil
+}
+
+// line is an unfolded LDIF line, numbered after the physical line it starts on.
+type line struct {
+	number int
+	text   string
+}
+
+func attribute(text string) (string, string, error) {
+	name, value, ok := strings.Cut(text, ":")
+	if !ok {
+		return "", "", fmt.Errorf("missing ':' in %q", text)
+	}
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasPrefix(value, ":"):
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[1:]))
+		if err != nil {
+			return "", "", fmt.Errorf("invalid base64 value of %s: %w", name, err)
+		}
+		return name, string(decoded), nil
+	case strings.HasPrefix(value, "<"):
+		return "", "", fmt.Errorf("value of %s is read from a URL, which is not supported", name)
+	}
+	return name, strings.TrimLeft(value, " "), nil
+}
@@ -0,0 +1,85 @@
+// Package locale selects the language messages are written in.
+package locale
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	English      = "en"
+	PortugueseBR = "pt-BR"
+	Spanish      = "es"
+
+	// Default is used when the client asks for no language, or for none supported, so
+	// existing clients keep their English messages.
+	Default = English
+)
+
+// Supported lists the languages with a message catalog.
+var Supported = []string{English, PortugueseBR, Spanish}
+
+type contextKey struct{}
+
+// Negotiate picks the supported language an Accept-Language header prefers, by
+// quality and then by order. A language matches its regional variants both ways, so
+// "pt" and "pt-PT" get pt-BR and "es-AR" gets es.
+func Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		tag     string
+		quality float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if tag != "" && quality > 0 {
+			candidates = append(candidates, candidate{tag: strings.TrimSpace(tag), quality: quality})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+
+	for _, c := range candidates {
+		if language := match(c.tag); language != "" {
+			return language
+		}
+	}
+	return Default
+}
+
+func match(tag string) string {
+	primary, _, _ := strings.Cut(tag, "-")
+	for _, language := range Supported {
+		if strings.EqualFold(tag, language) {
+			return language
+		}
+	}
+	for _, language := range Supported {
+		supported, _, _ := strings.Cut(language, "-")
+		if strings.EqualFold(primary, supported) {
+			return language
+		}
+	}
+	return ""
+}
+
+func NewContext(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, contextKey{}, language)
+}
+
+// FromContext returns the language of ctx, or Default when there is none.
+func FromContext(ctx context.Context) string {
+	if language, ok := ctx.Value(contextKey{}).(string); ok {
+		return language
+	}
+	return Default
+}
@@ -0,0 +1,39 @@
+package locale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tt := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"no header", "", Default},
+		{"exact match", "pt-BR", PortugueseBR},
+		{"case insensitive", "PT-br", PortugueseBR},
+		{"language without region", "pt", PortugueseBR},
+		{"other region", "es-AR,es;q=0.9", Spanish},
+		{"by quality", "en;q=0.5, es;q=0.8", Spanish},
+		{"by order on equal quality", "es, pt-BR", Spanish},
+		{"unsupported first", "fr-FR, pt-BR;q=0.7", PortugueseBR},
+		{"only unsupported", "fr, de", Default},
+		{"zero quality", "pt-BR;q=0, es;q=0.1", Spanish},
+		{"wildcard", "*", Default},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Negotiate(test.header))
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Default, FromContext(context.Background()))
+	assert.Equal(t, Spanish, FromContext(NewContext(context.Background(), Spanish)))
+}
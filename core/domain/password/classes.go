@@ -0,0 +1,49 @@
+package password
+
+import (
+	"password-validator/core/domain/policy"
+	"unicode"
+)
+
+// classCodes lists the character classes, by the code of the rule requiring each.
+var classCodes = []string{CodeDigit, CodeLowercase, CodeUppercase, CodeSpecialCharacter}
+
+// classOf returns the code of the class c belongs to under p, or "" for none. With
+// ASCIIClasses, letters and digits outside ASCII belong to no class; with
+// AnySpecial, every character that is not a letter or digit is special.
+func classOf(c rune, p policy.Policy) string {
+	switch {
+	case p.ASCIIClasses && c >= '0' && c <= '9', !p.ASCIIClasses && unicode.IsDigit(c):
+		return CodeDigit
+	case p.ASCIIClasses && c >= 'a' && c <= 'z', !p.ASCIIClasses && unicode.IsLower(c):
+		return CodeLowercase
+	case p.ASCIIClasses && c >= 'A' && c <= 'Z', !p.ASCIIClasses && unicode.IsUpper(c):
+		return CodeUppercase
+	case p.AnySpecial && !unicode.IsLetter(c) && !unicode.IsDigit(c), containsRune(p.SpecialChars, c):
+		return CodeSpecialCharacter
+	}
+	return ""
+}
+
+// required returns how many characters of a class p requires: the class minimum
+// when set, one when the class is only required, zero otherwise.
+func required(p policy.Policy, code string) int {
+	flag, minimum := false, 0
+	switch code {
+	case CodeDigit:
+		flag, minimum = p.RequireDigit, p.MinDigits
+	case CodeLowercase:
+		flag, minimum = p.RequireLower, p.MinLower
+	case CodeUppercase:
+		flag, minimum = p.RequireUpper, p.MinUpper
+	case CodeSpecialCharacter:
+		flag, minimum = p.RequireSpecial, p.MinSpecial
+	}
+	if minimum > 0 {
+		return minimum
+	}
+	if flag {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,180 @@
+package password
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	constants "password-validator/core/utils"
+)
+
+const (
+	DefaultGeneratedLength = 16
+	MaxGeneratedLength     = 128
+	generateAttempts       = 10
+
+	generateDigits = "23456789"
+	generateLower  = "abcdefghijkmnpqrstuvwxyz"
+	generateUpper  = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+)
+
+// Generate returns a random password that satisfies policy. A zero length picks the
+// larger of DefaultGeneratedLength and the policy minimum, within the policy maximum. Look-alike characters such
+// as 0/O and 1/l are left out of the alphabet.
+func Generate(policy policy.Policy, length int) (*Password, error) {
+	classMinimum := 0
+	for _, code := range classCodes {
+		classMinimum += required(policy, code)
+	}
+	minimum := max(policy.MinLength, policy.MinUnique, classMinimum)
+	maximum := MaxGeneratedLength
+	if policy.MaxLength > 0 {
+		maximum = min(maximum, policy.MaxLength)
+	}
+	if length == 0 {
+		length = min(max(DefaultGeneratedLength, minimum), maximum)
+	}
+	if length < minimum || length > maximum {
+		return nil, _errors.InvalidField{
+			Field: "length",
+			AsIs:  fmt.Sprintf("Must be between %d and %d", minimum, maximum),
+		}
+	}
+
+	specials := policy.SpecialChars
+	if specials == "" {
+		specials = constants.SPECIAL_CHARS
+	}
+	classes := []string{generateDigits, generateLower, generateUpper, specials}
+	alphabet := generateDigits + generateLower + generateUpper + specials
+	distinct := make(map[rune]bool)
+	for _, c := range alphabet {
+		distinct[policy.FoldRepetition(c)] = true
+	}
+	if policy.MinUnique > len(distinct) {
+		return nil, _errors.InvalidField{
+			Field: "policy",
+			AsIs:  fmt.Sprintf("Requires %d different characters, more than the %d the generator uses", policy.MinUnique, len(distinct)),
+		}
+	}
+	limit := occurrenceLimit(policy)
+	for i, class := range classes {
+		if count := required(policy, classCodes[i]); limit > 0 && count > len([]rune(class))*limit {
+			return nil, _errors.InvalidField{
+				Field: "policy",
+				AsIs:  fmt.Sprintf("Requires %s, more than the generator can draw", classNoun(classCodes[i], count)),
+			}
+		}
+	}
+	if limit > 0 && length > len(distinct)*limit {
+		message := fmt.Sprintf("Must be at most %d without repeated characters", len(distinct))
+		if limit > 1 {
+			message = fmt.Sprintf("Must be at most %d with each character used at most %d times", len(distinct)*limit, limit)
+		}
+		return nil, _errors.InvalidField{Field: "length", AsIs: message}
+	}
+
+	var err error
+	for range generateAttempts {
+		var candidate []rune
+		candidate, err = generateCandidate(classes, alphabet, length, policy)
+		if err != nil {
+			return nil, err
+		}
+		breakRuns(candidate, policy)
+
+		var p *Password
+		p, err = New(WithPassword(string(candidate)), WithPolicy(policy))
+		if err == nil {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("could not generate a password for policy '%s': %w", policy.Name, err)
+}
+
+// occurrenceLimit returns how many times the policy lets a character appear, zero
+// for no limit.
+func occurrenceLimit(policy policy.Policy) int {
+	if !policy.AllowRepeated {
+		return 1
+	}
+	return policy.MaxOccurrences
+}
+
+// generateCandidate draws the characters each class requires first, at least one,
+// then fills and shuffles the rest from the whole alphabet. Filling only repeats a
+// character once MinUnique different ones are drawn.
+func generateCandidate(classes []string, alphabet string, length int, policy policy.Policy) ([]rune, error) {
+	limit := occurrenceLimit(policy)
+	used := make(map[rune]int)
+	candidate := make([]rune, 0, length)
+	draw := func(from []rune, fresh bool) error {
+		for {
+			i, err := rand.Int(rand.Reader, big.NewInt(int64(len(from))))
+			if err != nil {
+				return err
+			}
+			c := from[i.Int64()]
+			count := used[policy.FoldRepetition(c)]
+			if fresh && len(used) < policy.MinUnique && count > 0 {
+				continue
+			}
+			if limit == 0 || count < limit {
+				used[policy.FoldRepetition(c)]++
+				candidate = append(candidate, c)
+				return nil
+			}
+		}
+	}
+
+	for i, class := range classes {
+		for range max(1, required(policy, classCodes[i])) {
+			if len(candidate) < length {
+				if err := draw([]rune(class), false); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for len(candidate) < length {
+		if err := draw([]rune(alphabet), true); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := len(candidate) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return nil, err
+		}
+		candidate[i], candidate[j.Int64()] = candidate[j.Int64()], candidate[i]
+	}
+	return candidate, nil
+}
+
+// breakRuns swaps a character that makes a run longer than the policy allows with
+// the next different one, so a strict MaxConsecutive does not depend on luck.
+func breakRuns(candidate []rune, policy policy.Policy) {
+	if policy.MaxConsecutive == 0 {
+		return
+	}
+	run := 0
+	for i := range candidate {
+		if i > 0 && policy.FoldRepetition(candidate[i]) == policy.FoldRepetition(candidate[i-1]) {
+			run++
+		} else {
+			run = 1
+		}
+		if run <= policy.MaxConsecutive {
+			continue
+		}
+		for j := i + 1; j < len(candidate); j++ {
+			if policy.FoldRepetition(candidate[j]) != policy.FoldRepetition(candidate[i]) {
+				candidate[i], candidate[j] = candidate[j], candidate[i]
+				run = 1
+				break
+			}
+		}
+	}
+}
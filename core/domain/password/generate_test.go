@@ -0,0 +1,102 @@
+package password
+
+import (
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	tt := []struct {
+		name           string
+		policy         policy.Policy
+		length         int
+		expectedLength int
+		expectedErr    error
+	}{
+		{
+			name:           "default length",
+			policy:         policy.Default(),
+			expectedLength: DefaultGeneratedLength,
+		},
+		{
+			name:           "policy minimum above default length",
+			policy:         policy.Policy{Name: "long", MinLength: 20, RequireDigit: true, RequireSpecial: true, SpecialChars: "_"},
+			expectedLength: 20,
+		},
+		{
+			name:           "repeats allowed beyond alphabet size",
+			policy:         policy.Policy{Name: "repeats", MinLength: 9, AllowRepeated: true},
+			length:         MaxGeneratedLength,
+			expectedLength: MaxGeneratedLength,
+		},
+		{
+			name:           "repetition limits",
+			policy:         policy.Policy{Name: "limits", MinLength: 9, AllowRepeated: true, MaxConsecutive: 1, MaxOccurrences: 2, CaseInsensitiveRepetition: true},
+			length:         80,
+			expectedLength: 80,
+		},
+		{
+			name:           "minimum unique characters above default length",
+			policy:         policy.Policy{Name: "unique", MinLength: 9, AllowRepeated: true, MinUnique: 20},
+			expectedLength: 20,
+		},
+		{
+			name:        "longer than the occurrence limit allows",
+			policy:      policy.Policy{Name: "limits", MinLength: 9, AllowRepeated: true, MaxOccurrences: 2, CaseInsensitiveRepetition: true},
+			length:      100,
+			expectedErr: _errors.InvalidField{Field: "length", AsIs: "Must be at most 90 with each character used at most 2 times"},
+		},
+		{
+			name:           "policy maximum below default length",
+			policy:         policy.Policy{Name: "short", MinLength: 9, MaxLength: 12},
+			expectedLength: 12,
+		},
+		{
+			name:        "above policy maximum",
+			policy:      policy.Policy{Name: "short", MinLength: 9, MaxLength: 12},
+			length:      13,
+			expectedErr: _errors.InvalidField{Field: "length", AsIs: "Must be between 9 and 12"},
+		},
+		{
+			name:           "class minimums",
+			policy:         policy.Policy{Name: "classes", MinLength: 9, MinDigits: 4, MinSpecial: 3, SpecialChars: "!@#$%", RequireUpper: true, MinClasses: 3},
+			expectedLength: DefaultGeneratedLength,
+		},
+		{
+			name:        "class minimum above the alphabet without repeats",
+			policy:      policy.Policy{Name: "digits", MinLength: 9, MinDigits: 9},
+			expectedErr: _errors.InvalidField{Field: "policy", AsIs: "Requires 9 digits, more than the generator can draw"},
+		},
+		{
+			name:        "below policy minimum",
+			policy:      policy.Default(),
+			length:      8,
+			expectedErr: _errors.InvalidField{Field: "length", AsIs: "Must be between 9 and 128"},
+		},
+		{
+			name:        "longer than the alphabet without repeats",
+			policy:      policy.Default(),
+			length:      100,
+			expectedErr: _errors.InvalidField{Field: "length", AsIs: "Must be at most 68 without repeated characters"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			for range 20 {
+				p, err := Generate(test.policy, test.length)
+
+				assert.Equal(t, test.expectedErr, err)
+				if test.expectedErr != nil {
+					return
+				}
+				assert.True(t, p.IsValid())
+				assert.Len(t, []rune(p.Password()), test.expectedLength)
+				assert.Equal(t, test.policy.Name, p.Policy().Name)
+			}
+		})
+	}
+}
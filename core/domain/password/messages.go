@@ -0,0 +1,153 @@
+package password
+
+import (
+	"fmt"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/policy"
+)
+
+// Params are the values a rule message interpolates, by name. Like the codes, they
+// are stable, so clients can write messages of their own:
+//
+//	min_length, max_length            min or max, and whitespace (the policy mode)
+//	max_length, over the input size   maxBytes
+//	repetition rules                  ignoreCase, with max or min when the rule has a limit
+//	character class rules             min, and characters when the class is limited
+//	                                  to some ("0-9", or the policy specialChars)
+//	character_classes                 min
+type Params map[string]any
+
+// catalogs hold the message templates of each language in locale.Supported. Keys
+// missing from a catalog fall back to English.
+var catalogs = map[string]map[string]string{
+	locale.English: {
+		CodeMinLength:             "Must have at least %d characters%s",
+		CodeMaxLength:             "Must have at most %d characters%s",
+		"max_bytes":               "Must have at most %d bytes",
+		"length.strip":            " (excluding spaces)",
+		"length.collapse":         " (consecutive spaces count as one)",
+		CodeWhitespace:            "Must not contain spaces",
+		CodeUniqueCharacters:      "Must not contain repeated characters (%s)",
+		CodeConsecutiveCharacters: "Must not repeat a character more than %d times in a row (%s)",
+		CodeCharacterOccurrences:  "Must not use any character more than %d times (%s)",
+		CodeMinUniqueCharacters:   "Must contain at least %d different characters (%s)",
+		"spaces":                  "excluding spaces",
+		"spaces.ignore_case":      "excluding spaces, ignoring case",
+		"digit.one":               "Must contain at least one digit (%s)",
+		"digit.many":              "Must contain at least %d digits (%s)",
+		"lowercase.one":           "Must contain at least one lowercase letter (%s)",
+		"lowercase.many":          "Must contain at least %d lowercase letters (%s)",
+		"uppercase.one":           "Must contain at least one uppercase letter (%s)",
+		"uppercase.many":          "Must contain at least %d uppercase letters (%s)",
+		"special_character.one":   "Must contain at least one special character (%s)",
+		"special_character.many":  "Must contain at least %d special characters (%s)",
+		CodeCharacterClasses:      "Must contain at least %d of: digits, lowercase letters, uppercase letters, special characters (excluding spaces)",
+		CodeCommonPassword:        "Must not be a common password, even with digits or symbols appended",
+		CodeBlocklistedTerm:       "Must not contain names or terms related to the organization",
+		CodeInvalidCharacter:      "Must not contain control or unassigned characters",
+	},
+	locale.PortugueseBR: {
+		CodeMinLength:             "Deve ter pelo menos %d caracteres%s",
+		CodeMaxLength:             "Deve ter no máximo %d caracteres%s",
+		"max_bytes":               "Deve ter no máximo %d bytes",
+		"length.strip":            " (sem contar espaços)",
+		"length.collapse":         " (espaços seguidos contam como um)",
+		CodeWhitespace:            "Não deve conter espaços",
+		CodeUniqueCharacters:      "Não deve conter caracteres repetidos (%s)",
+		CodeConsecutiveCharacters: "Não deve repetir um caractere mais de %d vezes seguidas (%s)",
+		CodeCharacterOccurrences:  "Não deve usar nenhum caractere mais de %d vezes (%s)",
+		CodeMinUniqueCharacters:   "Deve conter pelo menos %d caracteres diferentes (%s)",
+		"spaces":                  "sem contar espaços",
+		"spaces.ignore_case":      "sem contar espaços, sem diferenciar maiúsculas e minúsculas",
+		"digit.one":               "Deve conter pelo menos um dígito (%s)",
+		"digit.many":              "Deve conter pelo menos %d dígitos (%s)",
+		"lowercase.one":           "Deve conter pelo menos uma letra minúscula (%s)",
+		"lowercase.many":          "Deve conter pelo menos %d letras minúsculas (%s)",
+		"uppercase.one":           "Deve conter pelo menos uma letra maiúscula (%s)",
+		"uppercase.many":          "Deve conter pelo menos %d letras maiúsculas (%s)",
+		"special_character.one":   "Deve conter pelo menos um caractere especial (%s)",
+		"special_character.many":  "Deve conter pelo menos %d caracteres especiais (%s)",
+		CodeCharacterClasses:      "Deve conter pelo menos %d entre: dígitos, letras minúsculas, letras maiúsculas, caracteres especiais (sem contar espaços)",
+		CodeCommonPassword:        "Não deve ser uma senha comum, mesmo com dígitos ou símbolos no final",
+		CodeBlocklistedTerm:       "Não deve conter nomes ou termos relacionados à organização",
+		CodeInvalidCharacter:      "Não deve conter caracteres de controle ou não atribuídos",
+	},
+	locale.Spanish: {
+		CodeMinLength:             "Debe tener al menos %d caracteres%s",
+		CodeMaxLength:             "Debe tener como máximo %d caracteres%s",
+		"max_bytes":               "Debe tener como máximo %d bytes",
+		"length.strip":            " (sin contar espacios)",
+		"length.collapse":         " (los espacios seguidos cuentan como uno)",
+		CodeWhitespace:            "No debe contener espacios",
+		CodeUniqueCharacters:      "No debe contener caracteres repetidos (%s)",
+		CodeConsecutiveCharacters: "No debe repetir un carácter más de %d veces seguidas (%s)",
+		CodeCharacterOccurrences:  "No debe usar ningún carácter más de %d veces (%s)",
+		CodeMinUniqueCharacters:   "Debe contener al menos %d caracteres distintos (%s)",
+		"spaces":                  "sin contar espacios",
+		"spaces.ignore_case":      "sin contar espacios, sin distinguir mayúsculas y minúsculas",
+		"digit.one":               "Debe contener al menos un dígito (%s)",
+		"digit.many":              "Debe contener al menos %d dígitos (%s)",
+		"lowercase.one":           "Debe contener al menos una letra minúscula (%s)",
+		"lowercase.many":          "Debe contener al menos %d letras minúsculas (%s)",
+		"uppercase.one":           "Debe contener al menos una letra mayúscula (%s)",
+		"uppercase.many":          "Debe contener al menos %d letras mayúsculas (%s)",
+		"special_character.one":   "Debe contener al menos un carácter especial (%s)",
+		"special_character.many":  "Debe contener al menos %d caracteres especiales (%s)",
+		CodeCharacterClasses:      "Debe contener al menos %d de: dígitos, letras minúsculas, letras mayúsculas, caracteres especiales (sin contar espacios)",
+		CodeCommonPassword:        "No debe ser una contraseña común, aunque termine en dígitos o símbolos",
+		CodeBlocklistedTerm:       "No debe contener nombres o términos relacionados con la organización",
+		CodeInvalidCharacter:      "No debe contener caracteres de control o no asignados",
+	},
+}
+
+// Message writes the message of a rule in language, from its code and params.
+// Unsupported languages get the English message.
+func Message(language, code string, params Params) string {
+	text := func(key string) string {
+		if t, ok := catalogs[language][key]; ok {
+			return t
+		}
+		return catalogs[locale.English][key]
+	}
+	lengthNote := func() string {
+		mode, _ := params["whitespace"].(string)
+		if mode == "" {
+			mode = policy.WhitespaceStrip
+		}
+		return text("length." + mode)
+	}
+	spacesNote := func() string {
+		if ignoreCase, _ := params["ignoreCase"].(bool); ignoreCase {
+			return text("spaces.ignore_case")
+		}
+		return text("spaces")
+	}
+
+	switch code {
+	case CodeMinLength:
+		return fmt.Sprintf(text(code), params["min"], lengthNote())
+	case CodeMaxLength:
+		if maxBytes, ok := params["maxBytes"]; ok {
+			return fmt.Sprintf(text("max_bytes"), maxBytes)
+		}
+		return fmt.Sprintf(text(code), params["max"], lengthNote())
+	case CodeUniqueCharacters:
+		return fmt.Sprintf(text(code), spacesNote())
+	case CodeConsecutiveCharacters, CodeCharacterOccurrences:
+		return fmt.Sprintf(text(code), params["max"], spacesNote())
+	case CodeMinUniqueCharacters:
+		return fmt.Sprintf(text(code), params["min"], spacesNote())
+	case CodeDigit, CodeLowercase, CodeUppercase, CodeSpecialCharacter:
+		note := text("spaces")
+		if characters, _ := params["characters"].(string); characters != "" {
+			note = characters + ", " + note
+		}
+		if params["min"] == 1 {
+			return fmt.Sprintf(text(code+".one"), note)
+		}
+		return fmt.Sprintf(text(code+".many"), params["min"], note)
+	case CodeCharacterClasses:
+		return fmt.Sprintf(text(code), params["min"])
+	}
+	return text(code)
+}
@@ -1,23 +1,72 @@
 package password
 
 import (
-	"fmt"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/dictionary"
+	"password-validator/core/domain/policy"
 	_errors "password-validator/core/errors"
-	constants "password-validator/core/utils"
+	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/secure/precis"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	CodeMinLength        = "min_length"
+	CodeMaxLength        = "max_length"
+	CodeUniqueCharacters = "unique_characters"
+	CodeDigit            = "digit"
+	CodeLowercase        = "lowercase"
+	CodeUppercase        = "uppercase"
+	CodeSpecialCharacter = "special_character"
+	CodeCharacterClasses = "character_classes"
+	CodeCommonPassword   = "common_password"
+	CodeBlocklistedTerm  = "blocklisted_term"
+	CodeInvalidCharacter = "invalid_character"
+	CodeWhitespace       = "whitespace"
+
+	CodeConsecutiveCharacters = "consecutive_characters"
+	CodeCharacterOccurrences  = "character_occurrences"
+	CodeMinUniqueCharacters   = "min_unique_characters"
 )
 
+// MaxInputBytes bounds the passwords validated at all, whatever the policy. Longer
+// input is rejected before normalization or any other check runs.
+const MaxInputBytes = 4096
+
 type (
 	Password struct {
-		password string
-		isValid  bool
+		password   string
+		policy     policy.Policy
+		blocklist  blocklist.Blocklist
+		evaluated  string
+		isValid    bool
+		violations []Violation
+		satisfied  []string
+	}
+
+	Violation struct {
+		Code    string
+		Message string
+		Params  Params
+	}
+
+	// Rule is a requirement a policy enforces, with the message reported when a
+	// password breaks it, in the default language, and the params to write it in
+	// another one with Message.
+	Rule struct {
+		Code    string
+		Message string
+		Params  Params
 	}
 
 	PasswordParams func(p *Password)
 )
 
 func New(params ...PasswordParams) (*Password, error) {
-	p := &Password{}
+	p := &Password{policy: policy.Default()}
 	for _, param := range params {
 		param(p)
 	}
@@ -31,74 +80,153 @@ func New(params ...PasswordParams) (*Password, error) {
 	return p, nil
 }
 
+// validate records every rule the password breaks, and every rule it satisfies, and
+// returns the first broken one as an InvalidField error. A password over the input
+// size or the policy maximum length is rejected for that alone, before the checks
+// that cost more, such as the dictionary and blocklist lookups.
 func (p *Password) validate() error {
-	trimmed := ""
-	for _, c := range p.password {
-		if !unicode.IsSpace(c) {
-			trimmed += string(c)
-		}
+	p.violations = nil
+	p.satisfied = nil
+	rules := p.policy
+	if len(p.password) > MaxInputBytes {
+		p.check(false, InputSizeRule())
+		return p.err()
 	}
-
-	if len(trimmed) < 9 {
-		return _errors.InvalidField{
-			Field: "password",
-			AsIs:  "Must have at least 9 characters (excluding spaces)",
-		}
+	p.normalize()
+	p.evaluated = evaluatedForm(p.password, rules.WhitespaceMode())
+	if rules.MaxLength > 0 && utf8.RuneCountInString(p.evaluated) > rules.MaxLength {
+		p.check(false, MaxLengthRule(rules))
+		return p.err()
 	}
 
-	digit := false
-	lower := false
-	upper := false
-	special := false
-	seen := make(map[rune]bool)
+	var trimmed strings.Builder
+	length := 0
+	classes := make(map[string]int)
+	space := false
+	occurrences := make(map[rune]int)
+	mostOccurrences := 0
+	run := 0
+	longestRun := 0
+	var previous rune
 
-	for _, c := range trimmed {
-		if seen[c] {
-			return _errors.InvalidField{
-				Field: "password",
-				AsIs:  "Must not contain repeated characters (excluding spaces)",
-			}
-		}
-		seen[c] = true
-
-		switch {
-		case unicode.IsDigit(c):
-			digit = true
-		case unicode.IsLower(c):
-			lower = true
-		case unicode.IsUpper(c):
-			upper = true
-		case containsRune(constants.SPECIAL_CHARS, c):
-			special = true
+	for _, c := range p.evaluated {
+		length++
+		if unicode.IsSpace(c) {
+			space = true
+			continue
 		}
-	}
+		trimmed.WriteRune(c)
 
-	if !digit {
-		return _errors.InvalidField{
-			Field: "password",
-			AsIs:  "Must contain at least one digit (excluding spaces)",
+		folded := rules.FoldRepetition(c)
+		occurrences[folded]++
+		mostOccurrences = max(mostOccurrences, occurrences[folded])
+		if run > 0 && folded == previous {
+			run++
+		} else {
+			run = 1
 		}
+		longestRun = max(longestRun, run)
+		previous = folded
+
+		classes[classOf(c, rules)]++
 	}
-	if !lower {
-		return _errors.InvalidField{
-			Field: "password",
-			AsIs:  "Must contain at least one lowercase letter (excluding spaces)",
+	present := 0
+	for _, code := range classCodes {
+		if classes[code] > 0 {
+			present++
 		}
 	}
-	if !upper {
+
+	common := false
+	if rules.RejectCommon {
+		_, common = dictionary.Common().Lookup(trimmed.String())
+	}
+
+	passed := map[string]bool{
+		CodeMinLength:        length >= rules.MinLength,
+		CodeMaxLength:        length <= rules.MaxLength,
+		CodeWhitespace:       !space,
+		CodeUniqueCharacters: mostOccurrences <= 1,
+		CodeDigit:            classes[CodeDigit] >= required(rules, CodeDigit),
+		CodeLowercase:        classes[CodeLowercase] >= required(rules, CodeLowercase),
+		CodeUppercase:        classes[CodeUppercase] >= required(rules, CodeUppercase),
+		CodeSpecialCharacter: classes[CodeSpecialCharacter] >= required(rules, CodeSpecialCharacter),
+		CodeCharacterClasses: present >= rules.MinClasses,
+		CodeCommonPassword:   !common,
+
+		CodeConsecutiveCharacters: longestRun <= rules.MaxConsecutive,
+		CodeCharacterOccurrences:  mostOccurrences <= rules.MaxOccurrences,
+		CodeMinUniqueCharacters:   len(occurrences) >= rules.MinUnique,
+	}
+	for _, rule := range Rules(rules) {
+		p.check(passed[rule.Code], rule)
+	}
+	if p.blocklist.Len() > 0 {
+		p.check(!p.blocklist.Match(p.password), BlocklistRule())
+	}
+	return p.err()
+}
+
+// err returns the first violation as an InvalidField error, nil when there is none.
+func (p *Password) err() error {
+	if len(p.violations) > 0 {
 		return _errors.InvalidField{
 			Field: "password",
-			AsIs:  "Must contain at least one uppercase letter (excluding spaces)",
+			AsIs:  p.violations[0].Message,
 		}
 	}
-	if !special {
-		return _errors.InvalidField{
-			Field: "password",
-			AsIs:  fmt.Sprintf("Must contain at least one special character (%s, excluding spaces)", constants.SPECIAL_CHARS),
+	return nil
+}
+
+// normalize enforces the RFC 8265 OpaqueString profile, so a password typed in NFC
+// or NFD form is the same secret. The profile normalizes to NFC, not NFKC, on
+// purpose: NFKC would turn distinct characters such as "²" and "2" or full-width
+// and ASCII letters into one, changing the secret the user chose and disagreeing
+// with every other OpaqueString implementation. Only the dictionary and blocklist
+// matching apply NFKC, where folding those variants is the point.
+//
+// ASCII whitespace such as tabs and newlines is first mapped to a space, as the
+// profile already does for other spaces, so it is left to the policy whitespace mode.
+// Control and unassigned characters are not allowed by the profile; the password is
+// then only normalized to NFC and reported.
+func (p *Password) normalize() {
+	if p.password == "" {
+		return
+	}
+	p.password = strings.Map(func(r rune) rune {
+		if r < utf8.RuneSelf && unicode.IsSpace(r) {
+			return ' '
 		}
+		return r
+	}, p.password)
+	enforced, err := precis.OpaqueString.String(p.password)
+	if err != nil {
+		p.password = norm.NFC.String(p.password)
+		p.check(false, InvalidCharacterRule())
+		return
 	}
+	p.password = enforced
+}
 
-	return nil
+// evaluatedForm returns the form of the password the rules check under a policy
+// whitespace mode.
+func evaluatedForm(password, mode string) string {
+	switch mode {
+	case policy.WhitespaceCount, policy.WhitespaceReject:
+		return password
+	case policy.WhitespaceCollapse:
+		return strings.Join(strings.Fields(password), " ")
+	default:
+		return strings.Join(strings.Fields(password), "")
+	}
+}
+
+func (p *Password) check(ok bool, rule Rule) {
+	if ok {
+		p.satisfied = append(p.satisfied, rule.Code)
+		return
+	}
+	p.violations = append(p.violations, Violation{Code: rule.Code, Message: rule.Message, Params: rule.Params})
 }
 
 func containsRune(s string, r rune) bool {
@@ -116,10 +244,43 @@ func WithPassword(password string) PasswordParams {
 	}
 }
 
+func WithPolicy(policy policy.Policy) PasswordParams {
+	return func(p *Password) {
+		p.policy = policy
+	}
+}
+
+// WithBlocklist rejects passwords matching an organization term list, on top of the
+// policy rules.
+func WithBlocklist(blocklist blocklist.Blocklist) PasswordParams {
+	return func(p *Password) {
+		p.blocklist = blocklist
+	}
+}
+
 func (p *Password) Password() string {
 	return p.password
 }
 
+func (p *Password) Policy() policy.Policy {
+	return p.policy
+}
+
 func (p *Password) IsValid() bool {
 	return p.isValid
 }
+
+func (p *Password) Violations() []Violation {
+	return p.violations
+}
+
+// Evaluated returns the form of the password the rules were checked against: the
+// normalized password after the policy whitespace mode is applied.
+func (p *Password) Evaluated() string {
+	return p.evaluated
+}
+
+// Satisfied returns the codes of the policy rules the password already follows.
+func (p *Password) Satisfied() []string {
+	return p.satisfied
+}
@@ -1,6 +1,11 @@
 package password
 
 import (
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -40,6 +45,160 @@ func TestPasswordValidation(t *testing.T) {
 	}
 }
 
+func TestPasswordUnicode(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		codes []string
+	}{
+		{"length counts characters, not bytes", "Ábçdé1!", []string{CodeMinLength}},
+		{"multibyte password", "Ábçdéf1!2", nil},
+		{"combining marks", "A\u0301bc\u0327de\u03011!2", []string{CodeMinLength}},
+		{"control character", "Abcdef1!2\x00", []string{CodeInvalidCharacter}},
+		{"tab is whitespace", "AbTp9!\tfok", nil},
+		{"newline is whitespace", "AbTp9!fok\r\n", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _ := New(WithPassword(tc.value))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v for password %q", tc.codes, codes, tc.value)
+			}
+		})
+	}
+
+	nfc, _ := New(WithPassword("Ábçdéf1!2"))
+	nfd, _ := New(WithPassword("A\u0301bc\u0327de\u0301f1!2"))
+	if nfc.Password() != nfd.Password() {
+		t.Errorf("NFC and NFD forms should be the same secret, got %q and %q", nfc.Password(), nfd.Password())
+	}
+	if !nfd.IsValid() {
+		t.Errorf("NFD password should be valid, got %v", nfd.Violations())
+	}
+}
+
+func TestWhitespaceModes(t *testing.T) {
+	cases := []struct {
+		name      string
+		mode      string
+		value     string
+		evaluated string
+		codes     []string
+	}{
+		{"strip ignores spaces", policy.WhitespaceStrip, "AbTp9 fok", "AbTp9fok", []string{CodeMinLength}},
+		{"count keeps spaces", policy.WhitespaceCount, "AbTp9 fok", "AbTp9 fok", nil},
+		{"count does not repeat spaces", policy.WhitespaceCount, "Ab Tp 9fok", "Ab Tp 9fok", nil},
+		{"reject", policy.WhitespaceReject, "AbTp9 fok", "AbTp9 fok", []string{CodeWhitespace}},
+		{"reject without spaces", policy.WhitespaceReject, "AbTp9!fok", "AbTp9!fok", nil},
+		{"collapse trims and merges runs", policy.WhitespaceCollapse, "  AbTp9   fok ", "AbTp9 fok", nil},
+		{"collapse counts a run once", policy.WhitespaceCollapse, "AbTp9    fo", "AbTp9 fo", []string{CodeMinLength}},
+		{"strip removes tabs", policy.WhitespaceStrip, "AbTp9\tfok", "AbTp9fok", []string{CodeMinLength}},
+		{"count keeps a tab as a space", policy.WhitespaceCount, "AbTp9\tfok", "AbTp9 fok", nil},
+		{"reject tabs", policy.WhitespaceReject, "AbTp9\tfok", "AbTp9 fok", []string{CodeWhitespace}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _ := New(WithPassword(tc.value), WithPolicy(policy.Policy{MinLength: 9, Whitespace: tc.mode}))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v for password '%s'", tc.codes, codes, tc.value)
+			}
+			if p.Evaluated() != tc.evaluated {
+				t.Errorf("expected evaluated form '%s', got '%s'", tc.evaluated, p.Evaluated())
+			}
+		})
+	}
+}
+
+func TestRepetitionRules(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy policy.Policy
+		value  string
+		codes  []string
+	}{
+		{"consecutive within limit", policy.Policy{AllowRepeated: true, MaxConsecutive: 2}, "aab aab", nil},
+		{"consecutive over limit", policy.Policy{AllowRepeated: true, MaxConsecutive: 2}, "abaaa", []string{CodeConsecutiveCharacters}},
+		{"consecutive ignoring case", policy.Policy{AllowRepeated: true, MaxConsecutive: 2, CaseInsensitiveRepetition: true}, "abaAa", []string{CodeConsecutiveCharacters}},
+		{"consecutive across spaces", policy.Policy{AllowRepeated: true, MaxConsecutive: 1}, "a a", []string{CodeConsecutiveCharacters}},
+		{"occurrences within limit", policy.Policy{AllowRepeated: true, MaxOccurrences: 2}, "abab", nil},
+		{"occurrences over limit", policy.Policy{AllowRepeated: true, MaxOccurrences: 2}, "ababa", []string{CodeCharacterOccurrences}},
+		{"occurrences ignoring case", policy.Policy{AllowRepeated: true, MaxOccurrences: 2, CaseInsensitiveRepetition: true}, "abAbA", []string{CodeCharacterOccurrences}},
+		{"unique characters", policy.Policy{AllowRepeated: true, MinUnique: 4}, "abcabc", []string{CodeMinUniqueCharacters}},
+		{"unique characters ignoring case", policy.Policy{AllowRepeated: true, MinUnique: 4}, "abcA", nil},
+		{"unique characters folded", policy.Policy{AllowRepeated: true, MinUnique: 4, CaseInsensitiveRepetition: true}, "abcA", []string{CodeMinUniqueCharacters}},
+		{"no repeats ignoring case", policy.Policy{CaseInsensitiveRepetition: true}, "abcA", []string{CodeUniqueCharacters}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _ := New(WithPassword(tc.value), WithPolicy(tc.policy))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v for password '%s'", tc.codes, codes, tc.value)
+			}
+		})
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	bounded := policy.Policy{MinLength: 9, MaxLength: 12, RequireDigit: true}
+	cases := []struct {
+		name   string
+		policy policy.Policy
+		value  string
+		codes  []string
+	}{
+		{"within the policy maximum", bounded, "AbTp9!fokXy", nil},
+		{"over the policy maximum stops at the length", bounded, "AbTp!fokXyZwQ", []string{CodeMaxLength}},
+		{"spaces excluded by default", bounded, "AbTp9 !fok Xy", nil},
+		{"over the input size", policy.Policy{AllowRepeated: true}, strings.Repeat("a", MaxInputBytes+1), []string{CodeMaxLength}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _ := New(WithPassword(tc.value), WithPolicy(tc.policy))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v", tc.codes, codes)
+			}
+		})
+	}
+}
+
+func TestCharacterClasses(t *testing.T) {
+	ad := policy.Policy{MinLength: 8, AllowRepeated: true, MinClasses: 3, ASCIIClasses: true, AnySpecial: true}
+	unicodeClasses := policy.Policy{MinLength: 8, AllowRepeated: true, MinClasses: 3}
+	counts := policy.Policy{AllowRepeated: true, MinDigits: 2, RequireUpper: true, MinSpecial: 2, SpecialChars: "!?"}
+	cases := []struct {
+		name   string
+		policy policy.Policy
+		value  string
+		codes  []string
+	}{
+		{"three of four classes", ad, "abcdEF12", nil},
+		{"one class", ad, "abcdefgh", []string{CodeCharacterClasses}},
+		{"any character is special", ad, "abcdef_1", nil},
+		{"non-ASCII letters count for no class", ad, "ÁÉÍÓabc1", []string{CodeCharacterClasses}},
+		{"non-ASCII letters count by default", unicodeClasses, "ÁÉÍÓabc1", nil},
+		{"class minimums", counts, "Ab12!?", nil},
+		{"below class minimums", counts, "Ab1!_", []string{CodeDigit, CodeSpecialCharacter}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _ := New(WithPassword(tc.value), WithPolicy(tc.policy))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v for password '%s'", tc.codes, codes, tc.value)
+			}
+		})
+	}
+}
+
 func TestWithPassword(t *testing.T) {
 	p := &Password{}
 	param := WithPassword("Test123!@#")
@@ -67,3 +226,99 @@ func TestContainsRune(t *testing.T) {
 		t.Errorf("containsRune should return true when rune is present")
 	}
 }
+
+func TestPasswordViolations(t *testing.T) {
+	rejectCommon := policy.Default()
+	rejectCommon.AllowRepeated = true
+	rejectCommon.RejectCommon = true
+	cases := []struct {
+		name   string
+		value  string
+		policy policy.Policy
+		codes  []string
+	}{
+		{"valid password", "Abcdef1!2", policy.Default(), nil},
+		{"all rules fail", "aa", policy.Default(), []string{CodeMinLength, CodeUniqueCharacters, CodeDigit, CodeUppercase, CodeSpecialCharacter}},
+		{"repeated and no special", "Abcdef12A", policy.Default(), []string{CodeUniqueCharacters, CodeSpecialCharacter}},
+		{"relaxed policy", "abcabc", policy.Policy{MinLength: 6, RequireLower: true, AllowRepeated: true}, nil},
+		{"custom special chars", "Abcdef1!2", policy.Policy{MinLength: 9, RequireSpecial: true, SpecialChars: "_"}, []string{CodeSpecialCharacter}},
+		{"common password", "Welcome1!", rejectCommon, []string{CodeCommonPassword}},
+		{"common password with spaces", "Welcome 2024 !", rejectCommon, []string{CodeCommonPassword}},
+		{"uncommon password", "AbTp9!fok", rejectCommon, nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := New(WithPassword(tc.value), WithPolicy(tc.policy))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v for password '%s'", tc.codes, codes, tc.value)
+			}
+			if (err == nil) != (tc.codes == nil) {
+				t.Errorf("expected error=%v, got %v", tc.codes != nil, err)
+			}
+			if err != nil && err.(_errors.InvalidField).AsIs != p.Violations()[0].Message {
+				t.Errorf("error should describe the first violation, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestPasswordSatisfied(t *testing.T) {
+	p, _ := New(WithPassword("Abcdef12A"))
+	expected := []string{CodeMinLength, CodeDigit, CodeLowercase, CodeUppercase}
+	if !reflect.DeepEqual(p.Satisfied(), expected) {
+		t.Errorf("expected satisfied rules %v, got %v", expected, p.Satisfied())
+	}
+
+	relaxed, _ := New(WithPassword("abc"), WithPolicy(policy.Policy{MinLength: 3, AllowRepeated: true}))
+	if !reflect.DeepEqual(relaxed.Satisfied(), []string{CodeMinLength}) {
+		t.Errorf("rules outside the policy should not be reported, got %v", relaxed.Satisfied())
+	}
+}
+
+func TestWithPolicy(t *testing.T) {
+	p := &Password{}
+	param := WithPolicy(policy.Policy{Name: "custom"})
+	param(p)
+	if p.Policy().Name != "custom" {
+		t.Errorf("WithPolicy did not set the policy correctly, got: %s", p.Policy().Name)
+	}
+}
+
+func TestWithBlocklist(t *testing.T) {
+	terms := blocklist.New([]string{"Acme"}, blocklist.DefaultSubstringLength)
+	cases := []struct {
+		name  string
+		value string
+		codes []string
+	}{
+		{"brand variation", "@cme1957!Xy", []string{CodeBlocklistedTerm}},
+		{"brand and policy violations", "acme", []string{CodeMinLength, CodeDigit, CodeUppercase, CodeSpecialCharacter, CodeBlocklistedTerm}},
+		{"unrelated password", "AbTp9!fok", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, _ := New(WithPassword(tc.value), WithBlocklist(terms))
+			codes := violationCodes(p)
+			if !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("expected violations %v, got %v for password '%s'", tc.codes, codes, tc.value)
+			}
+		})
+	}
+
+	p, _ := New(WithPassword("AbTp9!fok"), WithBlocklist(terms))
+	if satisfied := p.Satisfied(); satisfied[len(satisfied)-1] != CodeBlocklistedTerm {
+		t.Errorf("blocklist should be reported as satisfied, got %v", satisfied)
+	}
+}
+
+// violationCodes lists the codes of the rules p breaks, in order.
+func violationCodes(p *Password) []string {
+	var codes []string
+	for _, v := range p.Violations() {
+		codes = append(codes, v.Code)
+	}
+	return codes
+}
@@ -0,0 +1,114 @@
+package password
+
+import (
+	"fmt"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/policy"
+)
+
+// Rules returns the rules p enforces, in the order validation checks them.
+// Validation reports the same messages, so describing a policy can never drift from
+// what it executes.
+func Rules(p policy.Policy) []Rule {
+	whitespace := p.WhitespaceMode()
+	rules := []Rule{
+		newRule(CodeMinLength, Params{"min": p.MinLength, "whitespace": whitespace}),
+	}
+	if p.MaxLength > 0 {
+		rules = append(rules, MaxLengthRule(p))
+	}
+	if whitespace == policy.WhitespaceReject {
+		rules = append(rules, newRule(CodeWhitespace, nil))
+	}
+	ignoreCase := p.CaseInsensitiveRepetition
+	if !p.AllowRepeated {
+		rules = append(rules, newRule(CodeUniqueCharacters, Params{"ignoreCase": ignoreCase}))
+	}
+	if p.MaxConsecutive > 0 {
+		rules = append(rules, newRule(CodeConsecutiveCharacters, Params{"max": p.MaxConsecutive, "ignoreCase": ignoreCase}))
+	}
+	if p.MaxOccurrences > 0 {
+		rules = append(rules, newRule(CodeCharacterOccurrences, Params{"max": p.MaxOccurrences, "ignoreCase": ignoreCase}))
+	}
+	if p.MinUnique > 0 {
+		rules = append(rules, newRule(CodeMinUniqueCharacters, Params{"min": p.MinUnique, "ignoreCase": ignoreCase}))
+	}
+	for _, code := range classCodes {
+		if count := required(p, code); count > 0 {
+			params := Params{"min": count}
+			if characters := classCharacters(p, code); characters != "" {
+				params["characters"] = characters
+			}
+			rules = append(rules, newRule(code, params))
+		}
+	}
+	if p.MinClasses > 0 {
+		rules = append(rules, newRule(CodeCharacterClasses, Params{"min": p.MinClasses}))
+	}
+	if p.RejectCommon {
+		rules = append(rules, newRule(CodeCommonPassword, nil))
+	}
+	return rules
+}
+
+// newRule builds a rule with its message in the default language.
+func newRule(code string, params Params) Rule {
+	return Rule{Code: code, Message: Message(locale.Default, code, params), Params: params}
+}
+
+var classNouns = map[string][2]string{
+	CodeDigit:            {"digit", "digits"},
+	CodeLowercase:        {"lowercase letter", "lowercase letters"},
+	CodeUppercase:        {"uppercase letter", "uppercase letters"},
+	CodeSpecialCharacter: {"special character", "special characters"},
+}
+
+// asciiRanges describe the classes restricted by ASCIIClasses.
+var asciiRanges = map[string]string{
+	CodeDigit:     "0-9",
+	CodeLowercase: "a-z",
+	CodeUppercase: "A-Z",
+}
+
+func classNoun(code string, count int) string {
+	if count == 1 {
+		return "one " + classNouns[code][0]
+	}
+	return fmt.Sprintf("%d %s", count, classNouns[code][1])
+}
+
+// classCharacters lists the characters of a class when it is restricted to some.
+func classCharacters(p policy.Policy, code string) string {
+	if code == CodeSpecialCharacter {
+		if p.AnySpecial {
+			return ""
+		}
+		return p.SpecialChars
+	}
+	if p.ASCIIClasses {
+		return asciiRanges[code]
+	}
+	return ""
+}
+
+func MaxLengthRule(p policy.Policy) Rule {
+	return newRule(CodeMaxLength, Params{"max": p.MaxLength, "whitespace": p.WhitespaceMode()})
+}
+
+// InputSizeRule bounds every password, policy or not. Like InvalidCharacterRule, it
+// is only reported when broken.
+func InputSizeRule() Rule {
+	return newRule(CodeMaxLength, Params{"maxBytes": MaxInputBytes})
+}
+
+// BlocklistRule is checked when an organization blocklist is configured. It does not
+// depend on the policy, so it is not part of Rules.
+func BlocklistRule() Rule {
+	return newRule(CodeBlocklistedTerm, nil)
+}
+
+// InvalidCharacterRule is only reported when broken: every printable password
+// satisfies it, so listing it with the policy rules would add noise.
+func InvalidCharacterRule() Rule {
+	return newRule(CodeInvalidCharacter, nil)
+}
@@ -0,0 +1,110 @@
+package password
+
+import (
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/policy"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRules(t *testing.T) {
+	tt := []struct {
+		name          string
+		policy        policy.Policy
+		expectedCodes []string
+	}{
+		{
+			name:          "default policy",
+			policy:        policy.Default(),
+			expectedCodes: []string{CodeMinLength, CodeUniqueCharacters, CodeDigit, CodeLowercase, CodeUppercase, CodeSpecialCharacter},
+		},
+		{
+			name:          "passphrase policy",
+			policy:        policy.Policy{Name: "passphrase", MinLength: 16, AllowRepeated: true},
+			expectedCodes: []string{CodeMinLength},
+		},
+		{
+			name:          "common passwords rejected",
+			policy:        policy.Policy{Name: "common", MinLength: 8, AllowRepeated: true, RejectCommon: true},
+			expectedCodes: []string{CodeMinLength, CodeCommonPassword},
+		},
+		{
+			name:          "repetition rules",
+			policy:        policy.Policy{Name: "repetition", MinLength: 12, AllowRepeated: true, MaxConsecutive: 2, MaxOccurrences: 3, MinUnique: 6},
+			expectedCodes: []string{CodeMinLength, CodeConsecutiveCharacters, CodeCharacterOccurrences, CodeMinUniqueCharacters},
+		},
+		{
+			name:          "spaces rejected",
+			policy:        policy.Policy{Name: "no-spaces", MinLength: 9, AllowRepeated: true, Whitespace: policy.WhitespaceReject},
+			expectedCodes: []string{CodeMinLength, CodeWhitespace},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			rules := Rules(test.policy)
+
+			var codes []string
+			for _, rule := range rules {
+				codes = append(codes, rule.Code)
+			}
+			assert.Equal(t, test.expectedCodes, codes)
+		})
+	}
+}
+
+func TestClassRuleMessages(t *testing.T) {
+	rules := Rules(policy.Policy{AllowRepeated: true, MinDigits: 2, RequireLower: true, RequireSpecial: true, MinClasses: 3, ASCIIClasses: true, AnySpecial: true})
+
+	assert.Equal(t, []Rule{
+		{Code: CodeMinLength, Message: "Must have at least 0 characters (excluding spaces)", Params: Params{"min": 0, "whitespace": "strip"}},
+		{Code: CodeDigit, Message: "Must contain at least 2 digits (0-9, excluding spaces)", Params: Params{"min": 2, "characters": "0-9"}},
+		{Code: CodeLowercase, Message: "Must contain at least one lowercase letter (a-z, excluding spaces)", Params: Params{"min": 1, "characters": "a-z"}},
+		{Code: CodeSpecialCharacter, Message: "Must contain at least one special character (excluding spaces)", Params: Params{"min": 1}},
+		{Code: CodeCharacterClasses, Message: "Must contain at least 3 of: digits, lowercase letters, uppercase letters, special characters (excluding spaces)", Params: Params{"min": 3}},
+	}, rules)
+}
+
+func TestMessage(t *testing.T) {
+	tt := []struct {
+		name     string
+		language string
+		code     string
+		params   Params
+		expected string
+	}{
+		{"english", locale.English, CodeMinLength, Params{"min": 9, "whitespace": "strip"}, "Must have at least 9 characters (excluding spaces)"},
+		{"portuguese", locale.PortugueseBR, CodeMinLength, Params{"min": 9, "whitespace": "strip"}, "Deve ter pelo menos 9 caracteres (sem contar espaços)"},
+		{"spanish", locale.Spanish, CodeMinLength, Params{"min": 16, "whitespace": "count"}, "Debe tener al menos 16 caracteres"},
+		{"special characters", locale.PortugueseBR, CodeSpecialCharacter, Params{"min": 1, "characters": "!@#"}, "Deve conter pelo menos um caractere especial (!@#, sem contar espaços)"},
+		{"class count", locale.Spanish, CodeDigit, Params{"min": 2}, "Debe contener al menos 2 dígitos (sin contar espacios)"},
+		{"ignoring case", locale.PortugueseBR, CodeConsecutiveCharacters, Params{"max": 2, "ignoreCase": true}, "Não deve repetir um caractere mais de 2 vezes seguidas (sem contar espaços, sem diferenciar maiúsculas e minúsculas)"},
+		{"input size", locale.Spanish, CodeMaxLength, Params{"maxBytes": 4096}, "Debe tener como máximo 4096 bytes"},
+		{"unsupported language", "fr", CodeBlocklistedTerm, nil, "Must not contain names or terms related to the organization"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Message(test.language, test.code, test.params))
+		})
+	}
+}
+
+// Every catalog must write every message, or a language would silently fall back to
+// English.
+func TestCatalogsAreComplete(t *testing.T) {
+	for _, language := range locale.Supported {
+		for key := range catalogs[locale.English] {
+			assert.Contains(t, catalogs[language], key, "%s is missing from %s", key, language)
+		}
+	}
+}
+
+func TestRulesMatchViolations(t *testing.T) {
+	p, _ := New(WithPassword(""), WithPolicy(policy.Policy{MinLength: 9, RequireDigit: true, SpecialChars: "!"}))
+	rules := Rules(p.Policy())
+
+	assert.Equal(t, rules[0].Message, p.Violations()[0].Message)
+	assert.Equal(t, "Must have at least 9 characters (excluding spaces)", rules[0].Message)
+}
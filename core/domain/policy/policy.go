@@ -0,0 +1,327 @@
+package policy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	_errors "password-validator/core/errors"
+	constants "password-validator/core/utils"
+	"unicode"
+)
+
+const DefaultName = "default"
+
+// Whitespace modes decide which form of the password the rules check.
+const (
+	// WhitespaceStrip removes every space before checking. It is the default.
+	WhitespaceStrip = "strip"
+	// WhitespaceCount keeps spaces: they count towards the length but are not
+	// characters that can repeat.
+	WhitespaceCount = "count"
+	// WhitespaceReject makes any space a violation.
+	WhitespaceReject = "reject"
+	// WhitespaceCollapse trims the password and turns each run of spaces into one
+	// space, which then counts like WhitespaceCount.
+	WhitespaceCollapse = "collapse"
+)
+
+type (
+	Policy struct {
+		Name           string `json:"name"`
+		Description    string `json:"description,omitempty"`
+		MinLength      int    `json:"minLength"`
+		MaxLength      int    `json:"maxLength,omitempty"`
+		RequireDigit   bool   `json:"requireDigit"`
+		RequireLower   bool   `json:"requireLower"`
+		RequireUpper   bool   `json:"requireUpper"`
+		RequireSpecial bool   `json:"requireSpecial"`
+		SpecialChars   string `json:"specialChars,omitempty"`
+		AllowRepeated  bool   `json:"allowRepeated"`
+		RejectCommon   bool   `json:"rejectCommon,omitempty"`
+		Whitespace     string `json:"whitespace,omitempty"`
+
+		// Character class rules on top of the Require flags: minimum counts per
+		// class, and a minimum number of distinct classes ("3 of 4"). ASCIIClasses
+		// restricts letters and digits to ASCII and AnySpecial makes every other
+		// character special.
+		MinDigits    int  `json:"minDigits,omitempty"`
+		MinLower     int  `json:"minLower,omitempty"`
+		MinUpper     int  `json:"minUpper,omitempty"`
+		MinSpecial   int  `json:"minSpecial,omitempty"`
+		MinClasses   int  `json:"minClasses,omitempty"`
+		ASCIIClasses bool `json:"asciiClasses,omitempty"`
+		AnySpecial   bool `json:"anySpecial,omitempty"`
+
+		// Repetition rules, each disabled when zero. They apply on top of
+		// AllowRepeated, which rejects any character used twice.
+		MaxConsecutive            int  `json:"maxConsecutive,omitempty"`
+		MaxOccurrences            int  `json:"maxOccurrences,omitempty"`
+		MinUnique                 int  `json:"minUnique,omitempty"`
+		CaseInsensitiveRepetition bool `json:"caseInsensitiveRepetition,omitempty"`
+	}
+
+	// Document is the policy file format shared by the server and the CLI. History
+	// keeps retired versions of the policies, so results produced under them can still
+	// be looked up after the rules tighten. Shadows maps a policy name to a candidate
+	// policy evaluated alongside it without affecting the result.
+	Document struct {
+		Default  string            `json:"default"`
+		Policies []Policy          `json:"policies"`
+		History  []Policy          `json:"history,omitempty"`
+		Shadows  map[string]string `json:"shadows,omitempty"`
+	}
+)
+
+// Default returns the rules the validator has always applied.
+func Default() Policy {
+	return Policy{
+		Name:           DefaultName,
+		Description:    "Nine or more characters, one of each character class and no repeated characters",
+		MinLength:      9,
+		RequireDigit:   true,
+		RequireLower:   true,
+		RequireUpper:   true,
+		RequireSpecial: true,
+		SpecialChars:   constants.SPECIAL_CHARS,
+	}
+}
+
+func DefaultDocument() Document {
+	return Document{
+		Default:  DefaultName,
+		Policies: []Policy{Default()},
+	}
+}
+
+func LoadFile(path string) (Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Document{}, err
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (Document, error) {
+	var d Document
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&d); err != nil {
+		return Document{}, fmt.Errorf("error decoding policy document: %w", err)
+	}
+	if len(d.Policies) == 0 {
+		return Document{}, _errors.InvalidField{Field: "policies", AsIs: "Must declare at least one policy"}
+	}
+
+	names := make(map[string]bool, len(d.Policies))
+	for i := range d.Policies {
+		field := fmt.Sprintf("policies[%d]", i)
+		if err := normalize(field, &d.Policies[i]); err != nil {
+			return Document{}, err
+		}
+		if names[d.Policies[i].Name] {
+			return Document{}, _errors.InvalidField{Field: field + ".name", AsIs: fmt.Sprintf("Policy '%s' is declared more than once", d.Policies[i].Name)}
+		}
+		names[d.Policies[i].Name] = true
+	}
+	for i := range d.History {
+		if err := normalize(fmt.Sprintf("history[%d]", i), &d.History[i]); err != nil {
+			return Document{}, err
+		}
+	}
+
+	if d.Default == "" {
+		d.Default = d.Policies[0].Name
+	}
+	if !names[d.Default] {
+		return Document{}, _errors.InvalidField{Field: "default", AsIs: fmt.Sprintf("Policy '%s' is not declared", d.Default)}
+	}
+	for active, candidate := range d.Shadows {
+		field := fmt.Sprintf("shadows.%s", active)
+		if !names[active] {
+			return Document{}, _errors.InvalidField{Field: field, AsIs: fmt.Sprintf("Policy '%s' is not declared", active)}
+		}
+		if !names[candidate] {
+			return Document{}, _errors.InvalidField{Field: field, AsIs: fmt.Sprintf("Policy '%s' is not declared", candidate)}
+		}
+		if active == candidate {
+			return Document{}, _errors.InvalidField{Field: field, AsIs: "Must not shadow itself"}
+		}
+	}
+	return d, nil
+}
+
+func normalize(field string, p *Policy) error {
+	if p.Name == "" {
+		return _errors.InvalidField{Field: field + ".name", AsIs: "Must not be empty"}
+	}
+	counts := []struct {
+		name  string
+		value int
+	}{
+		{"minLength", p.MinLength},
+		{"maxLength", p.MaxLength},
+		{"maxConsecutive", p.MaxConsecutive},
+		{"maxOccurrences", p.MaxOccurrences},
+		{"minUnique", p.MinUnique},
+		{"minDigits", p.MinDigits},
+		{"minLower", p.MinLower},
+		{"minUpper", p.MinUpper},
+		{"minSpecial", p.MinSpecial},
+		{"minClasses", p.MinClasses},
+	}
+	for _, count := range counts {
+		if count.value < 0 {
+			return _errors.InvalidField{Field: field + "." + count.name, AsIs: "Must not be negative"}
+		}
+	}
+	*p = p.WithDefaults()
+	if p.MinClasses > 4 {
+		return _errors.InvalidField{Field: field + ".minClasses", AsIs: "Must not be greater than 4"}
+	}
+	if p.MaxLength > 0 && p.MaxLength < p.MinLength {
+		return _errors.InvalidField{Field: field + ".maxLength", AsIs: "Must not be lower than minLength"}
+	}
+	switch p.Whitespace {
+	case "", WhitespaceCount, WhitespaceReject, WhitespaceCollapse:
+	default:
+		return _errors.InvalidField{Field: field + ".whitespace", AsIs: "Must be one of strip, count, reject or collapse"}
+	}
+	return nil
+}
+
+// Validate checks p the way Parse checks each policy of a document, and returns it
+// with the defaults of its omitted fields.
+func Validate(p Policy) (Policy, error) {
+	if err := normalize("policy", &p); err != nil {
+		return Policy{}, err
+	}
+	return p, nil
+}
+
+// WithDefaults returns p with the defaults Parse fills in: the default special
+// characters when special characters are required and none are listed, and an empty
+// whitespace mode for strip, so spelling out the default keeps the policy version.
+func (p Policy) WithDefaults() Policy {
+	if (p.RequireSpecial || p.MinSpecial > 0 || p.MinClasses > 0) && !p.AnySpecial && p.SpecialChars == "" {
+		p.SpecialChars = constants.SPECIAL_CHARS
+	}
+	if p.Whitespace == WhitespaceStrip {
+		p.Whitespace = ""
+	}
+	return p
+}
+
+// FoldRepetition maps c onto the character repetition rules count it as: its
+// lowercase form when CaseInsensitiveRepetition is set, itself otherwise.
+func (p Policy) FoldRepetition(c rune) rune {
+	if p.CaseInsensitiveRepetition {
+		return unicode.ToLower(c)
+	}
+	return c
+}
+
+// WhitespaceMode returns the whitespace mode, WhitespaceStrip when unset.
+func (p Policy) WhitespaceMode() string {
+	if p.Whitespace == "" {
+		return WhitespaceStrip
+	}
+	return p.Whitespace
+}
+
+// Version identifies the rules of a policy: it is a hash of every field but the name
+// and description, so it changes whenever the rules do. Rule fields must be omitempty
+// for adding one to leave the versions of existing policies unchanged.
+func (p Policy) Version() string {
+	p.Name = ""
+	p.Description = ""
+	data, _ := json.Marshal(p)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:6])
+}
+
+// Versions returns every known version of the named policy, the current one first
+// and then the retired ones in the order they appear in History.
+func (d Document) Versions(name string) ([]Policy, error) {
+	var versions []Policy
+	seen := make(map[string]bool)
+	for _, p := range append(append([]Policy{}, d.Policies...), d.History...) {
+		if p.Name == name && !seen[p.Version()] {
+			seen[p.Version()] = true
+			versions = append(versions, p)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, _errors.NotFoundError{Entity: "Policy", ID: name}
+	}
+	return versions, nil
+}
+
+// FindVersion returns the named policy as it was at the given version.
+func (d Document) FindVersion(name, version string) (Policy, error) {
+	versions, err := d.Versions(name)
+	if err != nil {
+		return Policy{}, err
+	}
+	for _, p := range versions {
+		if p.Version() == version {
+			return p, nil
+		}
+	}
+	return Policy{}, _errors.NotFoundError{
+		Entity: "Policy",
+		ID:     name + "@" + version,
+	}
+}
+
+// Supersede returns d with the policies and history of previous appended to its own
+// history, so the versions d replaces stay addressable. Versions that are current in
+// d, or repeated, are left out.
+func (d Document) Supersede(previous Document) Document {
+	seen := make(map[string]bool)
+	for _, p := range d.Policies {
+		seen[p.Name+"@"+p.Version()] = true
+	}
+
+	var history []Policy
+	for _, p := range append(append(append([]Policy{}, d.History...), previous.Policies...), previous.History...) {
+		key := p.Name + "@" + p.Version()
+		if !seen[key] {
+			seen[key] = true
+			history = append(history, p)
+		}
+	}
+	d.History = history
+	return d
+}
+
+// Shadow returns the candidate policy evaluated alongside the named one, or a
+// NotFoundError when it has none.
+func (d Document) Shadow(name string) (Policy, error) {
+	if name == "" {
+		name = d.Default
+	}
+	candidate, ok := d.Shadows[name]
+	if !ok {
+		return Policy{}, _errors.NotFoundError{Entity: "Shadow policy", ID: name}
+	}
+	return d.Find(candidate)
+}
+
+// Find returns the policy with the given name, or the document default when name is empty.
+func (d Document) Find(name string) (Policy, error) {
+	if name == "" {
+		name = d.Default
+	}
+	for _, p := range d.Policies {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Policy{}, _errors.NotFoundError{
+		Entity: "Policy",
+		ID:     name,
+	}
+}
@@ -0,0 +1,203 @@
+package policy
+
+import (
+	_errors "password-validator/core/errors"
+	constants "password-validator/core/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		output      Document
+		expectedErr error
+	}{
+		{
+			name:  "default falls back to the first policy",
+			input: `{"policies":[{"name":"strict","minLength":12,"requireSpecial":true},{"name":"relaxed","minLength":6}]}`,
+			output: Document{
+				Default: "strict",
+				Policies: []Policy{
+					{Name: "strict", MinLength: 12, RequireSpecial: true, SpecialChars: constants.SPECIAL_CHARS},
+					{Name: "relaxed", MinLength: 6},
+				},
+			},
+		},
+		{
+			name:        "no policies",
+			input:       `{"policies":[]}`,
+			expectedErr: _errors.InvalidField{Field: "policies", AsIs: "Must declare at least one policy"},
+		},
+		{
+			name:        "duplicated name",
+			input:       `{"policies":[{"name":"a"},{"name":"a"}]}`,
+			expectedErr: _errors.InvalidField{Field: "policies[1].name", AsIs: "Policy 'a' is declared more than once"},
+		},
+		{
+			name:        "negative length",
+			input:       `{"policies":[{"name":"a","minLength":-1}]}`,
+			expectedErr: _errors.InvalidField{Field: "policies[0].minLength", AsIs: "Must not be negative"},
+		},
+		{
+			name:  "explicit default whitespace mode",
+			input: `{"policies":[{"name":"a","whitespace":"strip"},{"name":"b","whitespace":"count"}]}`,
+			output: Document{
+				Default:  "a",
+				Policies: []Policy{{Name: "a"}, {Name: "b", Whitespace: WhitespaceCount}},
+			},
+		},
+		{
+			name:        "unknown whitespace mode",
+			input:       `{"policies":[{"name":"a","whitespace":"trim"}]}`,
+			expectedErr: _errors.InvalidField{Field: "policies[0].whitespace", AsIs: "Must be one of strip, count, reject or collapse"},
+		},
+		{
+			name:        "negative repetition limit",
+			input:       `{"policies":[{"name":"a","maxConsecutive":-2}]}`,
+			expectedErr: _errors.InvalidField{Field: "policies[0].maxConsecutive", AsIs: "Must not be negative"},
+		},
+		{
+			name:        "maximum below minimum length",
+			input:       `{"policies":[{"name":"a","minLength":12,"maxLength":8}]}`,
+			expectedErr: _errors.InvalidField{Field: "policies[0].maxLength", AsIs: "Must not be lower than minLength"},
+		},
+		{
+			name:        "more classes than exist",
+			input:       `{"policies":[{"name":"a","minClasses":5}]}`,
+			expectedErr: _errors.InvalidField{Field: "policies[0].minClasses", AsIs: "Must not be greater than 4"},
+		},
+		{
+			name:        "unknown default",
+			input:       `{"default":"b","policies":[{"name":"a"}]}`,
+			expectedErr: _errors.InvalidField{Field: "default", AsIs: "Policy 'b' is not declared"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := Parse([]byte(test.input))
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.output, d)
+		})
+	}
+}
+
+func TestParseRejectsUnknownFields(t *testing.T) {
+	_, err := Parse([]byte(`{"policies":[{"name":"a","minLenght":9}]}`))
+
+	assert.Error(t, err)
+}
+
+func TestFind(t *testing.T) {
+	d := DefaultDocument()
+
+	p, err := d.Find("")
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), p)
+
+	_, err = d.Find("missing")
+	assert.Equal(t, _errors.NotFoundError{Entity: "Policy", ID: "missing"}, err)
+}
+
+func TestVersion(t *testing.T) {
+	p := Default()
+	renamed := Default()
+	renamed.Name = "renamed"
+	renamed.Description = "Same rules, different label"
+	tightened := Default()
+	tightened.MinLength = 12
+
+	assert.Len(t, p.Version(), 12)
+	assert.Equal(t, p.Version(), Default().Version())
+	assert.Equal(t, p.Version(), renamed.Version())
+	assert.NotEqual(t, p.Version(), tightened.Version())
+}
+
+func TestVersions(t *testing.T) {
+	current := Policy{Name: "default", MinLength: 12}
+	retired := Policy{Name: "default", MinLength: 9}
+	d, err := Parse([]byte(`{"policies":[{"name":"default","minLength":12}],"history":[{"name":"default","minLength":9},{"name":"default","minLength":12}]}`))
+	assert.NoError(t, err)
+
+	versions, err := d.Versions("default")
+	assert.NoError(t, err)
+	assert.Equal(t, []Policy{current, retired}, versions)
+
+	p, err := d.FindVersion("default", retired.Version())
+	assert.NoError(t, err)
+	assert.Equal(t, retired, p)
+
+	_, err = d.FindVersion("default", "000000000000")
+	assert.Equal(t, _errors.NotFoundError{Entity: "Policy", ID: "default@000000000000"}, err)
+
+	_, err = d.Versions("missing")
+	assert.Equal(t, _errors.NotFoundError{Entity: "Policy", ID: "missing"}, err)
+}
+
+func TestParseValidatesHistory(t *testing.T) {
+	_, err := Parse([]byte(`{"policies":[{"name":"a"}],"history":[{"minLength":9}]}`))
+
+	assert.Equal(t, _errors.InvalidField{Field: "history[0].name", AsIs: "Must not be empty"}, err)
+}
+
+func TestShadow(t *testing.T) {
+	d, err := Parse([]byte(`{"policies":[{"name":"default","minLength":9},{"name":"strict","minLength":12}],"shadows":{"default":"strict"}}`))
+	assert.NoError(t, err)
+
+	candidate, err := d.Shadow("")
+	assert.NoError(t, err)
+	assert.Equal(t, "strict", candidate.Name)
+
+	_, err = d.Shadow("strict")
+	assert.Equal(t, _errors.NotFoundError{Entity: "Shadow policy", ID: "strict"}, err)
+}
+
+func TestParseValidatesShadows(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{
+			name:        "unknown candidate",
+			input:       `{"policies":[{"name":"a"}],"shadows":{"a":"b"}}`,
+			expectedErr: _errors.InvalidField{Field: "shadows.a", AsIs: "Policy 'b' is not declared"},
+		},
+		{
+			name:        "unknown active policy",
+			input:       `{"policies":[{"name":"a"}],"shadows":{"b":"a"}}`,
+			expectedErr: _errors.InvalidField{Field: "shadows.b", AsIs: "Policy 'b' is not declared"},
+		},
+		{
+			name:        "shadows itself",
+			input:       `{"policies":[{"name":"a"}],"shadows":{"a":"a"}}`,
+			expectedErr: _errors.InvalidField{Field: "shadows.a", AsIs: "Must not shadow itself"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.input))
+
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func TestSupersede(t *testing.T) {
+	v1 := Policy{Name: "default", MinLength: 8}
+	v2 := Policy{Name: "default", MinLength: 9}
+	v3 := Policy{Name: "default", MinLength: 12}
+	legacy := Policy{Name: "legacy", MinLength: 6}
+	previous := Document{Default: "default", Policies: []Policy{v2, legacy}, History: []Policy{v1}}
+
+	d := Document{Default: "default", Policies: []Policy{v3}}.Supersede(previous)
+	assert.Equal(t, []Policy{v2, legacy, v1}, d.History)
+
+	unchanged := previous.Supersede(previous)
+	assert.Equal(t, []Policy{v1}, unchanged.History)
+}
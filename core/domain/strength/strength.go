@@ -0,0 +1,124 @@
+package strength
+
+import (
+	"math"
+	"password-validator/core/domain/dictionary"
+	constants "password-validator/core/utils"
+	"strings"
+	"unicode"
+)
+
+const (
+	VeryWeak   = "very_weak"
+	Weak       = "weak"
+	Fair       = "fair"
+	Strong     = "strong"
+	VeryStrong = "very_strong"
+)
+
+// Entropy, in bits, a password needs to reach each score above zero.
+var thresholds = []float64{28, 36, 60, 80}
+
+var labels = []string{VeryWeak, Weak, Fair, Strong, VeryStrong}
+
+// Strength of a password. CommonRank is the rank of the password in the common
+// password list, after stripping appended digits and symbols, or 0 when it is not
+// listed.
+type Strength struct {
+	Score      int
+	Entropy    float64
+	Label      string
+	CommonRank int
+}
+
+// Estimate scores a password from 0 (very weak) to 4 (very strong) using the size of
+// the character pool it draws from and its length. Characters that repeat or continue
+// a sequence of the previous one ("aaa", "abc", "321") add less to the length. A
+// common password is only as strong as its rank in the common password list, plus
+// what its capitalization and appended characters add.
+func Estimate(password string) Strength {
+	runes := []rune(password)
+
+	var digit, lower, upper, special, other bool
+	length := 0.0
+	for i, c := range runes {
+		switch {
+		case c < unicode.MaxASCII && unicode.IsDigit(c):
+			digit = true
+		case c < unicode.MaxASCII && unicode.IsLower(c):
+			lower = true
+		case c < unicode.MaxASCII && unicode.IsUpper(c):
+			upper = true
+		case strings.ContainsRune(constants.SPECIAL_CHARS, c) || (c < unicode.MaxASCII && unicode.IsPrint(c)):
+			special = true
+		default:
+			other = true
+		}
+
+		switch {
+		case i > 0 && c == runes[i-1]:
+			length += 0.25
+		case i > 0 && (c == runes[i-1]+1 || c == runes[i-1]-1):
+			length += 0.5
+		default:
+			length++
+		}
+	}
+
+	pool := 0
+	if digit {
+		pool += 10
+	}
+	if lower {
+		pool += 26
+	}
+	if upper {
+		pool += 26
+	}
+	if special {
+		pool += 33
+	}
+	if other {
+		pool += 100
+	}
+
+	entropy := 0.0
+	if pool > 0 {
+		entropy = length * math.Log2(float64(pool))
+	}
+	match, common := dictionary.Common().Lookup(password)
+	if common {
+		entropy = math.Min(entropy, commonEntropy(password, match))
+	}
+	entropy = math.Round(entropy*100) / 100
+
+	score := 0
+	for _, threshold := range thresholds {
+		if entropy >= threshold {
+			score++
+		}
+	}
+	return Strength{
+		Score:      score,
+		Entropy:    entropy,
+		Label:      labels[score],
+		CommonRank: match.Rank,
+	}
+}
+
+// commonEntropy is the entropy of guessing a listed password in rank order, then its
+// capitalization, then the characters appended to it.
+func commonEntropy(password string, match dictionary.Match) float64 {
+	entropy := math.Log2(float64(match.Rank))
+	if strings.ToLower(password) != password {
+		entropy++
+	}
+	if suffix := len([]rune(match.Suffix)); suffix > 0 {
+		pool := 10.0
+		if strings.IndexFunc(match.Suffix, func(c rune) bool { return !unicode.IsDigit(c) }) >= 0 {
+			pool += 33
+		}
+		entropy += float64(suffix) * math.Log2(pool)
+	}
+	return entropy
+}
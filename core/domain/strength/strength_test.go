@@ -0,0 +1,63 @@
+package strength
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		score    int
+		label    string
+		entropyF func(float64) bool
+	}{
+		{"empty", "", 0, VeryWeak, func(e float64) bool { return e == 0 }},
+		{"short digits", "1234", 0, VeryWeak, func(e float64) bool { return e < 28 }},
+		{"repeated run", "aaaaaaaaaaaa", 0, VeryWeak, func(e float64) bool { return e < 28 }},
+		{"mixed classes", "AbTp9!fok", 2, Fair, func(e float64) bool { return e >= 36 && e < 60 }},
+		{"long mixed classes", "AbTp9!fokZx#2Lm", 4, VeryStrong, func(e float64) bool { return e >= 80 }},
+		{"long passphrase", "correct horse battery staple", 4, VeryStrong, func(e float64) bool { return e >= 80 }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Estimate(tc.value)
+
+			assert.Equal(t, tc.score, s.Score)
+			assert.Equal(t, tc.label, s.Label)
+			assert.True(t, tc.entropyF(s.Entropy), "unexpected entropy %v", s.Entropy)
+		})
+	}
+}
+
+func TestEstimatePenalizesSequences(t *testing.T) {
+	assert.Less(t, Estimate("abcdefgh").Entropy, Estimate("agdbhecf").Entropy)
+	assert.Less(t, Estimate("aaaabbbb").Entropy, Estimate("abababab").Entropy)
+}
+
+func TestEstimateCommonPasswords(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		rank  int
+	}{
+		{"most common", "password", 1},
+		{"capitalized with appended symbol", "Welcome1!", 1421},
+		{"appended year", "dragon2024", 7},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Estimate(tc.value)
+
+			assert.Equal(t, VeryWeak, s.Label)
+			assert.Equal(t, tc.rank, s.CommonRank)
+		})
+	}
+
+	assert.Zero(t, Estimate("AbTp9!fok").CommonRank)
+	assert.Less(t, Estimate("Welcome1!").Entropy, Estimate("Wzlcome1!").Entropy)
+}
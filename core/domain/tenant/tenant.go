@@ -0,0 +1,154 @@
+package tenant
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	_errors "password-validator/core/errors"
+	"path/filepath"
+)
+
+// Default is the tenant of requests that name no tenant. It always exists and uses
+// POLICY_FILE and BLOCKLIST_FILE.
+const Default = "default"
+
+type (
+	// Tenant is a business unit with its own policies, blocklist and stored passwords.
+	// APIKeys holds the SHA-256 hex digests of the keys that identify the tenant; a
+	// tenant with keys can only be selected by presenting one of them.
+	Tenant struct {
+		ID            string   `json:"id"`
+		APIKeys       []string `json:"apiKeys,omitempty"`
+		PolicyFile    string   `json:"policyFile,omitempty"`
+		BlocklistFile string   `json:"blocklistFile,omitempty"`
+	}
+
+	// Registry is the tenants file format. Tenants are fixed for the life of the
+	// process; their policy and blocklist files can be reloaded.
+	Registry struct {
+		Tenants []Tenant `json:"tenants"`
+	}
+
+	contextKey struct{}
+)
+
+// LoadFile reads a tenants file. Relative policy and blocklist files are resolved
+// against the directory of the tenants file.
+func LoadFile(path string) (Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Registry{}, err
+	}
+	r, err := Parse(data)
+	if err != nil {
+		return Registry{}, err
+	}
+	resolve := func(file string) string {
+		if file == "" || filepath.IsAbs(file) {
+			return file
+		}
+		return filepath.Join(filepath.Dir(path), file)
+	}
+	for i, t := range r.Tenants {
+		r.Tenants[i].PolicyFile = resolve(t.PolicyFile)
+		r.Tenants[i].BlocklistFile = resolve(t.BlocklistFile)
+	}
+	return r, nil
+}
+
+func Parse(data []byte) (Registry, error) {
+	var r Registry
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&r); err != nil {
+		return Registry{}, fmt.Errorf("error decoding tenants file: %w", err)
+	}
+
+	ids := make(map[string]bool, len(r.Tenants))
+	keys := make(map[string]bool)
+	for i, t := range r.Tenants {
+		field := fmt.Sprintf("tenants[%d]", i)
+		switch {
+		case t.ID == "":
+			return Registry{}, _errors.InvalidField{Field: field + ".id", AsIs: "Must not be empty"}
+		case t.ID == Default:
+			return Registry{}, _errors.InvalidField{Field: field + ".id", AsIs: fmt.Sprintf("'%s' is reserved", Default)}
+		case ids[t.ID]:
+			return Registry{}, _errors.InvalidField{Field: field + ".id", AsIs: fmt.Sprintf("Tenant '%s' is declared more than once", t.ID)}
+		}
+		ids[t.ID] = true
+		for j, key := range t.APIKeys {
+			keyField := fmt.Sprintf("%s.apiKeys[%d]", field, j)
+			if digest, err := hex.DecodeString(key); err != nil || len(digest) != sha256.Size {
+				return Registry{}, _errors.InvalidField{Field: keyField, AsIs: "Must be the SHA-256 hex digest of the key"}
+			}
+			if keys[key] {
+				return Registry{}, _errors.InvalidField{Field: keyField, AsIs: "Key is declared more than once"}
+			}
+			keys[key] = true
+		}
+	}
+	return r, nil
+}
+
+// IDs returns the default tenant followed by the declared ones.
+func (r Registry) IDs() []string {
+	ids := []string{Default}
+	for _, t := range r.Tenants {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
+
+// Resolve returns the tenant a request is made for, from its tenant ID and API key,
+// either of which may be empty. A key selects its tenant and must match the ID when
+// both are given. Without a key, only the default tenant and tenants without keys can
+// be selected.
+func (r Registry) Resolve(id, apiKey string) (string, error) {
+	if apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		digest := hex.EncodeToString(sum[:])
+		for _, t := range r.Tenants {
+			for _, key := range t.APIKeys {
+				if key != digest {
+					continue
+				}
+				if id != "" && id != t.ID {
+					return "", _errors.UnauthorizedError{Reason: "API key does not belong to the tenant"}
+				}
+				return t.ID, nil
+			}
+		}
+		return "", _errors.UnauthorizedError{Reason: "Unknown API key"}
+	}
+
+	if id == "" || id == Default {
+		return Default, nil
+	}
+	for _, t := range r.Tenants {
+		if t.ID != id {
+			continue
+		}
+		if len(t.APIKeys) > 0 {
+			return "", _errors.UnauthorizedError{Reason: "Tenant requires an API key"}
+		}
+		return t.ID, nil
+	}
+	return "", _errors.UnauthorizedError{Reason: fmt.Sprintf("Unknown tenant '%s'", id)}
+}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant of ctx, or the default tenant when there is none.
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(contextKey{}).(string); ok {
+		return id
+	}
+	return Default
+}
@@ -0,0 +1,159 @@
+package tenant
+
+import (
+	"context"
+	"os"
+	_errors "password-validator/core/errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	cardsKeyDigest = "2dd1ca33e0c22c628da491fae3723ec6134bfef22486aaa50c6a48a286ac98e6" // "cards-key"
+	loansKeyDigest = "c1eec84975f6d34221f6a0739b25c7e5289ff9e41ea1a9880aa8ab30cbaebb26" // "loans-key"
+)
+
+func TestParse(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		output      Registry
+		expectedErr error
+	}{
+		{
+			name:  "tenants",
+			input: `{"tenants":[{"id":"cards","apiKeys":["` + cardsKeyDigest + `"],"policyFile":"cards.json"},{"id":"loans"}]}`,
+			output: Registry{Tenants: []Tenant{
+				{ID: "cards", APIKeys: []string{cardsKeyDigest}, PolicyFile: "cards.json"},
+				{ID: "loans"},
+			}},
+		},
+		{
+			name:        "empty id",
+			input:       `{"tenants":[{"id":""}]}`,
+			expectedErr: _errors.InvalidField{Field: "tenants[0].id", AsIs: "Must not be empty"},
+		},
+		{
+			name:        "reserved id",
+			input:       `{"tenants":[{"id":"default"}]}`,
+			expectedErr: _errors.InvalidField{Field: "tenants[0].id", AsIs: "'default' is reserved"},
+		},
+		{
+			name:        "duplicated id",
+			input:       `{"tenants":[{"id":"cards"},{"id":"cards"}]}`,
+			expectedErr: _errors.InvalidField{Field: "tenants[1].id", AsIs: "Tenant 'cards' is declared more than once"},
+		},
+		{
+			name:        "plain text key",
+			input:       `{"tenants":[{"id":"cards","apiKeys":["cards-key"]}]}`,
+			expectedErr: _errors.InvalidField{Field: "tenants[0].apiKeys[0]", AsIs: "Must be the SHA-256 hex digest of the key"},
+		},
+		{
+			name:        "key shared by two tenants",
+			input:       `{"tenants":[{"id":"cards","apiKeys":["` + cardsKeyDigest + `"]},{"id":"loans","apiKeys":["` + cardsKeyDigest + `"]}]}`,
+			expectedErr: _errors.InvalidField{Field: "tenants[1].apiKeys[0]", AsIs: "Key is declared more than once"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse([]byte(test.input))
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.output, r)
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tenants.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"tenants":[{"id":"cards","policyFile":"cards.json","blocklistFile":"cards.txt"},{"id":"loans","policyFile":"/etc/loans.json"}]}`), 0o600))
+
+	r, err := LoadFile(path)
+
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "cards.json"), r.Tenants[0].PolicyFile)
+	assert.Equal(t, filepath.Join(dir, "cards.txt"), r.Tenants[0].BlocklistFile)
+	assert.Empty(t, r.Tenants[1].BlocklistFile)
+	assert.Equal(t, "/etc/loans.json", r.Tenants[1].PolicyFile)
+	assert.Equal(t, []string{Default, "cards", "loans"}, r.IDs())
+}
+
+func TestResolve(t *testing.T) {
+	r := Registry{Tenants: []Tenant{
+		{ID: "cards", APIKeys: []string{cardsKeyDigest}},
+		{ID: "loans", APIKeys: []string{loansKeyDigest}},
+		{ID: "internal"},
+	}}
+	tt := []struct {
+		name        string
+		id          string
+		apiKey      string
+		output      string
+		expectedErr error
+	}{
+		{
+			name:   "no tenant selects the default one",
+			output: Default,
+		},
+		{
+			name:   "default tenant by id",
+			id:     Default,
+			output: Default,
+		},
+		{
+			name:   "tenant by key",
+			apiKey: "cards-key",
+			output: "cards",
+		},
+		{
+			name:   "tenant by id and matching key",
+			id:     "loans",
+			apiKey: "loans-key",
+			output: "loans",
+		},
+		{
+			name:   "tenant without keys by id",
+			id:     "internal",
+			output: "internal",
+		},
+		{
+			name:        "key of another tenant",
+			id:          "cards",
+			apiKey:      "loans-key",
+			expectedErr: _errors.UnauthorizedError{Reason: "API key does not belong to the tenant"},
+		},
+		{
+			name:        "unknown key",
+			apiKey:      "other-key",
+			expectedErr: _errors.UnauthorizedError{Reason: "Unknown API key"},
+		},
+		{
+			name:        "tenant with keys by id only",
+			id:          "cards",
+			expectedErr: _errors.UnauthorizedError{Reason: "Tenant requires an API key"},
+		},
+		{
+			name:        "unknown tenant",
+			id:          "other",
+			expectedErr: _errors.UnauthorizedError{Reason: "Unknown tenant 'other'"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			id, err := r.Resolve(test.id, test.apiKey)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.output, id)
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Default, FromContext(context.Background()))
+	assert.Equal(t, "cards", FromContext(NewContext(context.Background(), "cards")))
+}
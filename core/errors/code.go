@@ -0,0 +1,27 @@
+package errors
+
+import "errors"
+
+// Stable, machine-readable codes of the errors, sent to clients along with the HTTP
+// or gRPC status. Not found errors have a code per entity, such as policy_not_found.
+const (
+	CodeInvalidField          = "invalid_field"
+	CodeUnauthorized          = "unauthorized"
+	CodeMalformedRequest      = "malformed_request"
+	CodeUnsupportedMediaType  = "unsupported_media_type"
+	CodePayloadTooLarge       = "payload_too_large"
+	CodeRateLimited           = "rate_limited"
+	CodeDependencyUnavailable = "dependency_unavailable"
+	CodeInternal              = "internal_error"
+)
+
+// Code returns the code of the first error in the chain of err that has one, or
+// CodeInternal when none has: an error without a code is a bug of ours, not bad
+// input.
+func Code(err error) string {
+	var coded interface{ Code() string }
+	if errors.As(err, &coded) {
+		return coded.Code()
+	}
+	return CodeInternal
+}
@@ -0,0 +1,40 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCode(t *testing.T) {
+	tt := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{name: "invalid field", err: InvalidField{Field: "password"}, code: CodeInvalidField},
+		{name: "not found by entity", err: NotFoundError{Entity: "Shadow policy"}, code: "shadow_policy_not_found"},
+		{name: "wrapped", err: fmt.Errorf("decoding: %w", MalformedRequestError{Cause: errors.New("EOF")}), code: CodeMalformedRequest},
+		{name: "rate limited", err: RateLimitedError{}, code: CodeRateLimited},
+		{name: "no code", err: errors.New("boom"), code: CodeInternal},
+	}
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.code, Code(test.err))
+		})
+	}
+}
+
+func TestCauses(t *testing.T) {
+	cause := errors.New("connection refused")
+
+	assert.ErrorIs(t, DependencyUnavailableError{Dependency: "policy store", Cause: cause}, cause)
+	assert.ErrorIs(t, PayloadTooLargeError{Limit: 8, Cause: cause}, cause)
+	assert.ErrorIs(t, NotFoundError{Entity: "Policy", ID: "strict", Cause: cause}, cause)
+	assert.ErrorIs(t, UnauthorizedError{Reason: "Unknown API key", Cause: cause}, cause)
+	assert.ErrorIs(t, InvalidField{Field: "length", AsIs: "Must be at most 128", Cause: cause}, cause)
+	assert.NoError(t, errors.Unwrap(NotFoundError{Entity: "Policy", ID: "strict"}))
+	assert.EqualError(t, DependencyUnavailableError{Dependency: "policy store", Cause: cause}, "Dependency policy store is unavailable. connection refused.")
+}
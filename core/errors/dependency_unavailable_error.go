@@ -0,0 +1,24 @@
+package errors
+
+import "fmt"
+
+// DependencyUnavailableError is a request that failed because a service or store
+// it depends on did not answer. The request itself may succeed when retried.
+type DependencyUnavailableError struct {
+	Dependency string
+	Cause      error
+}
+
+var _ error = (*DependencyUnavailableError)(nil)
+
+func (e DependencyUnavailableError) Error() string {
+	return fmt.Sprintf("Dependency %s is unavailable. %v.", e.Dependency, e.Cause)
+}
+
+func (e DependencyUnavailableError) Code() string {
+	return CodeDependencyUnavailable
+}
+
+func (e DependencyUnavailableError) Unwrap() error {
+	return e.Cause
+}
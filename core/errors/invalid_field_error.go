@@ -1,14 +1,39 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"password-validator/core/domain/locale"
+)
 
+// InvalidField reports a field that breaks a rule. Language is the language AsIs is
+// written in, so the whole message is written in it too; empty means English.
 type InvalidField struct {
-	Field string
-	AsIs  string
+	Field    string
+	AsIs     string
+	Language string
+	Cause    error
 }
 
 var _ error = (*InvalidField)(nil)
 
+var invalidFieldMessages = map[string]string{
+	locale.English:      "Field [%s] is invalid. %s.",
+	locale.PortugueseBR: "O campo [%s] é inválido. %s.",
+	locale.Spanish:      "El campo [%s] no es válido. %s.",
+}
+
 func (e InvalidField) Error() string {
-	return fmt.Sprintf("Field [%s] is invalid. %s.", e.Field, e.AsIs)
+	message, ok := invalidFieldMessages[e.Language]
+	if !ok {
+		message = invalidFieldMessages[locale.English]
+	}
+	return fmt.Sprintf(message, e.Field, e.AsIs)
+}
+
+func (e InvalidField) Code() string {
+	return CodeInvalidField
+}
+
+func (e InvalidField) Unwrap() error {
+	return e.Cause
 }
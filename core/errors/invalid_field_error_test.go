@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidFieldError(t *testing.T) {
+	tt := []struct {
+		name     string
+		err      InvalidField
+		expected string
+	}{
+		{name: "english by default", err: InvalidField{Field: "password", AsIs: "Must have at least 9 characters"}, expected: "Field [password] is invalid. Must have at least 9 characters."},
+		{name: "pt-BR", err: InvalidField{Field: "password", AsIs: "Deve ter pelo menos 9 caracteres", Language: "pt-BR"}, expected: "O campo [password] é inválido. Deve ter pelo menos 9 caracteres."},
+		{name: "es", err: InvalidField{Field: "password", AsIs: "Debe tener al menos 9 caracteres", Language: "es"}, expected: "El campo [password] no es válido. Debe tener al menos 9 caracteres."},
+		{name: "unsupported language", err: InvalidField{Field: "password", AsIs: "Must have at least 9 characters", Language: "fr"}, expected: "Field [password] is invalid. Must have at least 9 characters."},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.err.Error())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package errors
+
+import "fmt"
+
+// MalformedRequestError is a request that could not be read or decoded, such as a
+// body that is not valid JSON. Field, when set, is the field at fault.
+type MalformedRequestError struct {
+	Field string
+	Cause error
+}
+
+var _ error = (*MalformedRequestError)(nil)
+
+func (e MalformedRequestError) Error() string {
+	return fmt.Sprintf("Malformed request. %v.", e.Cause)
+}
+
+func (e MalformedRequestError) Code() string {
+	return CodeMalformedRequest
+}
+
+func (e MalformedRequestError) Unwrap() error {
+	return e.Cause
+}
@@ -1,10 +1,14 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type NotFoundError struct {
 	Entity string
 	ID     string
+	Cause  error
 }
 
 var _ error = (*NotFoundError)(nil)
@@ -12,3 +16,12 @@ var _ error = (*NotFoundError)(nil)
 func (e NotFoundError) Error() string {
 	return fmt.Sprintf("%s not found with ID '%s'", e.Entity, e.ID)
 }
+
+// Code is the entity in snake case followed by _not_found, as in policy_not_found.
+func (e NotFoundError) Code() string {
+	return strings.ReplaceAll(strings.ToLower(e.Entity), " ", "_") + "_not_found"
+}
+
+func (e NotFoundError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,22 @@
+package errors
+
+import "fmt"
+
+type PayloadTooLargeError struct {
+	Limit int64
+	Cause error
+}
+
+var _ error = (*PayloadTooLargeError)(nil)
+
+func (e PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("Payload too large. Request body exceeds %d bytes.", e.Limit)
+}
+
+func (e PayloadTooLargeError) Code() string {
+	return CodePayloadTooLarge
+}
+
+func (e PayloadTooLargeError) Unwrap() error {
+	return e.Cause
+}
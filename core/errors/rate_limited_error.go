@@ -0,0 +1,30 @@
+package errors
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateLimitedError is a request refused for going over a rate limit. RetryAfter,
+// when known, is how long the client should wait before trying again.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+	Cause      error
+}
+
+var _ error = (*RateLimitedError)(nil)
+
+func (e RateLimitedError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("Rate limited. Retry after %s.", e.RetryAfter)
+	}
+	return "Rate limited. Retry later."
+}
+
+func (e RateLimitedError) Code() string {
+	return CodeRateLimited
+}
+
+func (e RateLimitedError) Unwrap() error {
+	return e.Cause
+}
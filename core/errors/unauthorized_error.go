@@ -0,0 +1,22 @@
+package errors
+
+import "fmt"
+
+type UnauthorizedError struct {
+	Reason string
+	Cause  error
+}
+
+var _ error = (*UnauthorizedError)(nil)
+
+func (e UnauthorizedError) Error() string {
+	return fmt.Sprintf("Unauthorized. %s.", e.Reason)
+}
+
+func (e UnauthorizedError) Code() string {
+	return CodeUnauthorized
+}
+
+func (e UnauthorizedError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,26 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UnsupportedMediaTypeError struct {
+	ContentType string
+	Supported   []string
+	Cause       error
+}
+
+var _ error = (*UnsupportedMediaTypeError)(nil)
+
+func (e UnsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("Unsupported content type %q. Expected %s.", e.ContentType, strings.Join(e.Supported, " or "))
+}
+
+func (e UnsupportedMediaTypeError) Code() string {
+	return CodeUnsupportedMediaType
+}
+
+func (e UnsupportedMediaTypeError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/blocklist"
+)
+
+type BlocklistRepository interface {
+	Find(context.Context) (blocklist.Blocklist, error)
+}
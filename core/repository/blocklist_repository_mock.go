@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/blocklist"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type BlocklistRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *BlocklistRepositoryMock) Find(ctx context.Context) (blocklist.Blocklist, error) {
+	ret := m.Called(ctx)
+	return ret.Get(0).(blocklist.Blocklist), ret.Error(1)
+}
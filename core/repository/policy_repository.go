@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+)
+
+type PolicyRepository interface {
+	FindByName(context.Context, string) (policy.Policy, error)
+	FindAll(context.Context) ([]policy.Policy, error)
+	FindVersions(ctx context.Context, name string) ([]policy.Policy, error)
+	FindVersion(ctx context.Context, name, version string) (policy.Policy, error)
+	FindShadow(ctx context.Context, name string) (policy.Policy, error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type PolicyRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *PolicyRepositoryMock) FindByName(ctx context.Context, name string) (policy.Policy, error) {
+	ret := m.Called(ctx, name)
+	return ret.Get(0).(policy.Policy), ret.Error(1)
+}
+
+func (m *PolicyRepositoryMock) FindAll(ctx context.Context) ([]policy.Policy, error) {
+	ret := m.Called(ctx)
+	return ret.Get(0).([]policy.Policy), ret.Error(1)
+}
+
+func (m *PolicyRepositoryMock) FindVersions(ctx context.Context, name string) ([]policy.Policy, error) {
+	ret := m.Called(ctx, name)
+	return ret.Get(0).([]policy.Policy), ret.Error(1)
+}
+
+func (m *PolicyRepositoryMock) FindVersion(ctx context.Context, name, version string) (policy.Policy, error) {
+	ret := m.Called(ctx, name, version)
+	return ret.Get(0).(policy.Policy), ret.Error(1)
+}
+
+func (m *PolicyRepositoryMock) FindShadow(ctx context.Context, name string) (policy.Policy, error) {
+	ret := m.Called(ctx, name)
+	return ret.Get(0).(policy.Policy), ret.Error(1)
+}
@@ -8,4 +8,5 @@ import (
 type PasswordRepository interface {
 	Save(context.Context, *password.Password) error
 	FindById(context.Context, string) (*password.Password, error)
+	FindAll(context.Context) ([]*password.Password, error)
 }
@@ -20,3 +20,8 @@ func (m *PasswordRepositoryMock) FindById(ctx context.Context, pa string) (*pass
 	ret := m.Called(ctx, pa)
 	return ret.Get(0).(*password.Password), ret.Error(1)
 }
+
+func (m *PasswordRepositoryMock) FindAll(ctx context.Context) ([]*password.Password, error) {
+	ret := m.Called(ctx)
+	return ret.Get(0).([]*password.Password), ret.Error(1)
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/compliance"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	ComplianceReportUseCase interface {
+		Execute(context.Context, input.PolicyInput) (output.ComplianceOutput, error)
+	}
+
+	CompliancePresenter interface {
+		Output(ctx context.Context, facts compliance.Facts, findings []compliance.Finding) output.ComplianceOutput
+	}
+
+	complianceReportUseCase struct {
+		policyRepository    repository.PolicyRepository
+		blocklistRepository repository.BlocklistRepository
+		presenter           CompliancePresenter
+	}
+)
+
+func NewComplianceReportUseCase(
+	policyRepository repository.PolicyRepository,
+	blocklistRepository repository.BlocklistRepository,
+	presenter CompliancePresenter,
+) ComplianceReportUseCase {
+	return &complianceReportUseCase{
+		policyRepository:    policyRepository,
+		blocklistRepository: blocklistRepository,
+		presenter:           presenter,
+	}
+}
+
+// Execute assesses the named policy, with the blocklist validation applies along with
+// it, against the standards of the compliance package.
+func (u complianceReportUseCase) Execute(ctx context.Context, i input.PolicyInput) (output.ComplianceOutput, error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"policy": i.Name})
+	log.Info("Compliance report usecase initialized")
+
+	p, err := u.policyRepository.FindByName(ctx, i.Name)
+	if err != nil {
+		return output.ComplianceOutput{}, err
+	}
+	terms, err := u.blocklistRepository.Find(ctx)
+	if err != nil {
+		return output.ComplianceOutput{}, err
+	}
+
+	facts := compliance.Facts{Policy: p, Blocklist: terms.Len() > 0}
+	out := u.presenter.Output(ctx, facts, compliance.Assess(facts))
+	log.WithFields(logger.Field{"gaps": out.Summary.Gaps}).Info("Compliance report usecase finished")
+	return out, nil
+}
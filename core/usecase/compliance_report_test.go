@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/compliance"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type compliancePresenterMock struct{}
+
+func (compliancePresenterMock) Output(ctx context.Context, facts compliance.Facts, findings []compliance.Finding) output.ComplianceOutput {
+	return output.ComplianceOutput{Policy: facts.Policy.Name, Blocklist: facts.Blocklist, Summary: output.ComplianceSummary{Manual: len(findings)}}
+}
+
+func TestComplianceReportUseCase(t *testing.T) {
+	terms := blocklist.New([]string{"acme"}, blocklist.DefaultSubstringLength)
+	tt := []struct {
+		name        string
+		in          input.PolicyInput
+		policy      policy.Policy
+		policyErr   error
+		blocklist   blocklist.Blocklist
+		expected    output.ComplianceOutput
+		expectedErr error
+	}{
+		{
+			name:     "default policy without blocklist",
+			policy:   policy.Default(),
+			expected: output.ComplianceOutput{Policy: policy.DefaultName, Summary: output.ComplianceSummary{Manual: len(compliance.Assess(compliance.Facts{Policy: policy.Default()}))}},
+		},
+		{
+			name:      "blocklist applied",
+			in:        input.PolicyInput{Name: policy.DefaultName},
+			policy:    policy.Default(),
+			blocklist: terms,
+			expected:  output.ComplianceOutput{Policy: policy.DefaultName, Blocklist: true, Summary: output.ComplianceSummary{Manual: len(compliance.Assess(compliance.Facts{Policy: policy.Default()}))}},
+		},
+		{
+			name:        "policy not found",
+			in:          input.PolicyInput{Name: "missing"},
+			policyErr:   _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			expectedErr: _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, test.in.Name).Return(test.policy, test.policyErr)
+			blocklists := &repository.BlocklistRepositoryMock{}
+			blocklists.On("Find", mock.Anything).Return(test.blocklist, nil)
+			uc := NewComplianceReportUseCase(policies, blocklists, compliancePresenterMock{})
+
+			out, err := uc.Execute(context.Background(), test.in)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/strength"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	EstimateStrengthUseCase interface {
+		Execute(context.Context, input.StrengthInput) (output.StrengthOutput, error)
+	}
+
+	EstimateStrengthPresenter interface {
+		Output(context.Context, strength.Strength) output.StrengthOutput
+	}
+
+	estimateStrengthUseCase struct {
+		presenter EstimateStrengthPresenter
+	}
+)
+
+func NewEstimateStrengthUseCase(
+	presenter EstimateStrengthPresenter,
+) EstimateStrengthUseCase {
+	return &estimateStrengthUseCase{
+		presenter: presenter,
+	}
+}
+
+func (u estimateStrengthUseCase) Execute(ctx context.Context, i input.StrengthInput) (output.StrengthOutput, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Estimate strength usecase initialized")
+
+	if len(i.Password) > password.MaxInputBytes {
+		rule, language := password.InputSizeRule(), locale.FromContext(ctx)
+		return output.StrengthOutput{}, _errors.InvalidField{Field: "password", AsIs: password.Message(language, rule.Code, rule.Params), Language: language}
+	}
+	s := strength.Estimate(i.Password)
+
+	log.WithFields(logger.Field{"score": s.Score}).Info("Estimate strength usecase finished")
+	return u.presenter.Output(ctx, s), nil
+}
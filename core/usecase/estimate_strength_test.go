@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/strength"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type estimateStrengthPresenterMock struct{}
+
+func (estimateStrengthPresenterMock) Output(ctx context.Context, s strength.Strength) output.StrengthOutput {
+	return output.StrengthOutput{Score: s.Score, Entropy: s.Entropy, Label: s.Label}
+}
+
+func TestEstimateStrengthUseCase(t *testing.T) {
+	uc := NewEstimateStrengthUseCase(estimateStrengthPresenterMock{})
+
+	out, err := uc.Execute(context.Background(), input.StrengthInput{Password: "AbTp9!fok"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, strength.Estimate("AbTp9!fok").Score, out.Score)
+	assert.Equal(t, strength.Fair, out.Label)
+}
+
+func TestEstimateStrengthUseCaseInputSize(t *testing.T) {
+	uc := NewEstimateStrengthUseCase(estimateStrengthPresenterMock{})
+
+	_, err := uc.Execute(context.Background(), input.StrengthInput{Password: strings.Repeat("a", password.MaxInputBytes+1)})
+
+	assert.Equal(t, _errors.InvalidField{Field: "password", AsIs: "Must have at most 4096 bytes", Language: locale.English}, err)
+}
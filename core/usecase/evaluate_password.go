@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/password"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+)
+
+type (
+	// EvaluatePasswordUseCase checks a password like ValidatePasswordUseCase, but
+	// leaves no trace of it: the password is not saved, logged or counted, and no
+	// shadow policy is evaluated. It serves the streaming and live feedback
+	// endpoints, whose passwords are either too many to keep or only drafts.
+	EvaluatePasswordUseCase interface {
+		Execute(context.Context, input.PasswordInput) (output.PasswordOutput, error)
+	}
+
+	evaluatePasswordUseCase struct {
+		policyRepository repository.PolicyRepository
+		blocklists       repository.BlocklistRepository
+		presenter        ValidatePasswordPresenter
+	}
+)
+
+func NewEvaluatePasswordUseCase(
+	policyRepository repository.PolicyRepository,
+	blocklists repository.BlocklistRepository,
+	presenter ValidatePasswordPresenter,
+) EvaluatePasswordUseCase {
+	return &evaluatePasswordUseCase{
+		policyRepository: policyRepository,
+		blocklists:       blocklists,
+		presenter:        presenter,
+	}
+}
+
+func (u evaluatePasswordUseCase) Execute(ctx context.Context, i input.PasswordInput) (output.PasswordOutput, error) {
+	policy, err := u.policyRepository.FindByName(ctx, i.Policy)
+	if err != nil {
+		return output.PasswordOutput{}, err
+	}
+
+	terms, err := u.blocklists.Find(ctx)
+	if err != nil {
+		return output.PasswordOutput{}, err
+	}
+
+	p, err := password.New(
+		password.WithPassword(i.Password),
+		password.WithPolicy(policy),
+		password.WithBlocklist(terms),
+	)
+	if err != nil {
+		return u.presenter.Output(ctx, p), invalidPassword(ctx, p, err)
+	}
+	return u.presenter.Output(ctx, p), nil
+}
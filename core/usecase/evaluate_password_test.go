@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEvaluatePasswordUseCase(t *testing.T) {
+	tt := []struct {
+		name          string
+		password      string
+		expectedValid bool
+		expectedErr   error
+	}{
+		{
+			name:          "valid password",
+			password:      "AbTp9!fok",
+			expectedValid: true,
+		},
+		{
+			name:        "invalid password",
+			password:    "AbTp9!foA",
+			expectedErr: _errors.InvalidField{Field: "password", AsIs: "No debe contener caracteres repetidos (sin contar espacios)", Language: locale.Spanish},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+			uc := NewEvaluatePasswordUseCase(policies, emptyBlocklist(), &validatePasswordPresenterMock{})
+
+			out, err := uc.Execute(locale.NewContext(context.Background(), locale.Spanish), input.PasswordInput{Password: test.password})
+
+			assert.Equal(t, test.expectedValid, out.IsValid)
+			assert.Equal(t, test.expectedErr, err)
+			policies.AssertNotCalled(t, "FindShadow", mock.Anything, mock.Anything)
+		})
+	}
+}
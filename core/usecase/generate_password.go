@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/password"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	GeneratePasswordUseCase interface {
+		Execute(context.Context, input.GenerateInput) (output.GenerateOutput, error)
+	}
+
+	GeneratePasswordPresenter interface {
+		Output(context.Context, *password.Password) output.GenerateOutput
+	}
+
+	generatePasswordUseCase struct {
+		policyRepository repository.PolicyRepository
+		presenter        GeneratePasswordPresenter
+	}
+)
+
+func NewGeneratePasswordUseCase(
+	policyRepository repository.PolicyRepository,
+	presenter GeneratePasswordPresenter,
+) GeneratePasswordUseCase {
+	return &generatePasswordUseCase{
+		policyRepository: policyRepository,
+		presenter:        presenter,
+	}
+}
+
+// Execute never logs nor stores the generated password.
+func (u generatePasswordUseCase) Execute(ctx context.Context, i input.GenerateInput) (output.GenerateOutput, error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"policy": i.Policy, "length": i.Length})
+	log.Info("Generate password usecase initialized")
+
+	policy, err := u.policyRepository.FindByName(ctx, i.Policy)
+	if err != nil {
+		return output.GenerateOutput{}, err
+	}
+
+	p, err := password.Generate(policy, i.Length)
+	if err != nil {
+		return output.GenerateOutput{}, err
+	}
+
+	log.Info("Generate password usecase finished")
+	return u.presenter.Output(ctx, p), nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type generatePasswordPresenterMock struct{}
+
+func (generatePasswordPresenterMock) Output(ctx context.Context, p *password.Password) output.GenerateOutput {
+	return output.GenerateOutput{Password: p.Password(), Policy: p.Policy().Name}
+}
+
+func TestGeneratePasswordUseCase(t *testing.T) {
+	tt := []struct {
+		name      string
+		in        input.GenerateInput
+		policyErr error
+		err       error
+	}{
+		{
+			name: "successfully password generation",
+			in:   input.GenerateInput{Length: 12},
+		},
+		{
+			name:      "policy not found",
+			in:        input.GenerateInput{Policy: "missing"},
+			policyErr: _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			err:       _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+		},
+		{
+			name: "invalid length",
+			in:   input.GenerateInput{Length: 4},
+			err:  _errors.InvalidField{Field: "length", AsIs: "Must be between 9 and 128"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, test.in.Policy).Return(policy.Default(), test.policyErr)
+			uc := NewGeneratePasswordUseCase(policies, generatePasswordPresenterMock{})
+
+			out, err := uc.Execute(context.Background(), test.in)
+			if test.err != nil {
+				assert.Equal(t, test.err, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, out.Password, test.in.Length)
+			assert.Equal(t, policy.DefaultName, out.Policy)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package input
+
+type StrengthInput struct {
+	Password string `json:"password"`
+}
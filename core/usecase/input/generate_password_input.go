@@ -0,0 +1,6 @@
+package input
+
+type GenerateInput struct {
+	Policy string `json:"policy,omitempty"`
+	Length int    `json:"length,omitempty"`
+}
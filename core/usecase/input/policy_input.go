@@ -0,0 +1,10 @@
+package input
+
+type PolicyInput struct {
+	Name string `json:"name"`
+}
+
+type PolicyVersionInput struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
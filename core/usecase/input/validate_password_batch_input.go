@@ -0,0 +1,5 @@
+package input
+
+type PasswordBatchInput struct {
+	Passwords []PasswordInput `json:"passwords"`
+}
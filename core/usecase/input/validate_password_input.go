@@ -2,4 +2,5 @@ package input
 
 type PasswordInput struct {
 	Password string `json:"password"`
+	Policy   string `json:"policy,omitempty"`
 }
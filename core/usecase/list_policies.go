@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	ListPoliciesUseCase interface {
+		Execute(context.Context) (output.PolicyListOutput, error)
+	}
+
+	GetPolicyUseCase interface {
+		Execute(context.Context, input.PolicyInput) (output.PolicyOutput, error)
+	}
+
+	// PolicyPresenter describes a policy. Blocklist tells whether the tenant has a
+	// blocklist, which validation checks along with the policy rules.
+	PolicyPresenter interface {
+		Output(ctx context.Context, p policy.Policy, isDefault, blocklist bool) output.PolicyOutput
+	}
+
+	listPoliciesUseCase struct {
+		policyRepository    repository.PolicyRepository
+		blocklistRepository repository.BlocklistRepository
+		presenter           PolicyPresenter
+	}
+
+	getPolicyUseCase struct {
+		policyRepository    repository.PolicyRepository
+		blocklistRepository repository.BlocklistRepository
+		presenter           PolicyPresenter
+	}
+)
+
+func NewListPoliciesUseCase(
+	policyRepository repository.PolicyRepository,
+	blocklistRepository repository.BlocklistRepository,
+	presenter PolicyPresenter,
+) ListPoliciesUseCase {
+	return &listPoliciesUseCase{
+		policyRepository:    policyRepository,
+		blocklistRepository: blocklistRepository,
+		presenter:           presenter,
+	}
+}
+
+func NewGetPolicyUseCase(
+	policyRepository repository.PolicyRepository,
+	blocklistRepository repository.BlocklistRepository,
+	presenter PolicyPresenter,
+) GetPolicyUseCase {
+	return &getPolicyUseCase{
+		policyRepository:    policyRepository,
+		blocklistRepository: blocklistRepository,
+		presenter:           presenter,
+	}
+}
+
+func (u listPoliciesUseCase) Execute(ctx context.Context) (output.PolicyListOutput, error) {
+	log := logger.FromContext(ctx)
+	log.Info("List policies usecase initialized")
+
+	defaultPolicy, err := u.policyRepository.FindByName(ctx, "")
+	if err != nil {
+		return output.PolicyListOutput{}, err
+	}
+	policies, err := u.policyRepository.FindAll(ctx)
+	if err != nil {
+		return output.PolicyListOutput{}, err
+	}
+	terms, err := u.blocklistRepository.Find(ctx)
+	if err != nil {
+		return output.PolicyListOutput{}, err
+	}
+
+	out := output.PolicyListOutput{
+		Default:  defaultPolicy.Name,
+		Policies: make([]output.PolicyOutput, 0, len(policies)),
+	}
+	for _, p := range policies {
+		out.Policies = append(out.Policies, u.presenter.Output(ctx, p, p.Name == defaultPolicy.Name, terms.Len() > 0))
+	}
+
+	log.WithFields(logger.Field{"policies": len(out.Policies)}).Info("List policies usecase finished")
+	return out, nil
+}
+
+func (u getPolicyUseCase) Execute(ctx context.Context, i input.PolicyInput) (output.PolicyOutput, error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"policy": i.Name})
+	log.Info("Get policy usecase initialized")
+
+	defaultPolicy, err := u.policyRepository.FindByName(ctx, "")
+	if err != nil {
+		return output.PolicyOutput{}, err
+	}
+	p, err := u.policyRepository.FindByName(ctx, i.Name)
+	if err != nil {
+		return output.PolicyOutput{}, err
+	}
+	terms, err := u.blocklistRepository.Find(ctx)
+	if err != nil {
+		return output.PolicyOutput{}, err
+	}
+
+	log.Info("Get policy usecase finished")
+	return u.presenter.Output(ctx, p, p.Name == defaultPolicy.Name, terms.Len() > 0), nil
+}
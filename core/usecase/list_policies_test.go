@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type policyPresenterMock struct{}
+
+func (policyPresenterMock) Output(ctx context.Context, p policy.Policy, isDefault, blocklist bool) output.PolicyOutput {
+	return output.PolicyOutput{Name: p.Name, Default: isDefault, Blocklist: blocklist}
+}
+
+func blocklistOf(terms ...string) *repository.BlocklistRepositoryMock {
+	blocklists := &repository.BlocklistRepositoryMock{}
+	blocklists.On("Find", mock.Anything).Return(blocklist.New(terms, blocklist.DefaultSubstringLength), nil)
+	return blocklists
+}
+
+func TestListPoliciesUseCase(t *testing.T) {
+	passphrase := policy.Policy{Name: "passphrase", MinLength: 16, AllowRepeated: true}
+	tt := []struct {
+		name        string
+		policies    []policy.Policy
+		terms       []string
+		findAllErr  error
+		expected    output.PolicyListOutput
+		expectedErr error
+	}{
+		{
+			name:     "lists every policy and flags the default",
+			policies: []policy.Policy{policy.Default(), passphrase},
+			expected: output.PolicyListOutput{
+				Default: policy.DefaultName,
+				Policies: []output.PolicyOutput{
+					{Name: policy.DefaultName, Default: true},
+					{Name: "passphrase"},
+				},
+			},
+		},
+		{
+			name:     "flags the tenant blocklist",
+			policies: []policy.Policy{policy.Default()},
+			terms:    []string{"acme"},
+			expected: output.PolicyListOutput{
+				Default:  policy.DefaultName,
+				Policies: []output.PolicyOutput{{Name: policy.DefaultName, Default: true, Blocklist: true}},
+			},
+		},
+		{
+			name:        "repository error",
+			policies:    []policy.Policy{},
+			findAllErr:  errors.New("test"),
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+			policies.On("FindAll", mock.Anything).Return(test.policies, test.findAllErr)
+			uc := NewListPoliciesUseCase(policies, blocklistOf(test.terms...), policyPresenterMock{})
+
+			out, err := uc.Execute(context.Background())
+
+			assert.Equal(t, test.expectedErr, err)
+			if test.expectedErr == nil {
+				assert.Equal(t, test.expected, out)
+			}
+		})
+	}
+}
+
+func TestGetPolicyUseCase(t *testing.T) {
+	passphrase := policy.Policy{Name: "passphrase", MinLength: 16, AllowRepeated: true}
+	tt := []struct {
+		name        string
+		in          input.PolicyInput
+		policy      policy.Policy
+		terms       []string
+		policyErr   error
+		expected    output.PolicyOutput
+		expectedErr error
+	}{
+		{
+			name:     "default policy",
+			in:       input.PolicyInput{Name: policy.DefaultName},
+			policy:   policy.Default(),
+			expected: output.PolicyOutput{Name: policy.DefaultName, Default: true},
+		},
+		{
+			name:     "named policy",
+			in:       input.PolicyInput{Name: "passphrase"},
+			policy:   passphrase,
+			expected: output.PolicyOutput{Name: "passphrase"},
+		},
+		{
+			name:     "tenant with a blocklist",
+			in:       input.PolicyInput{Name: "passphrase"},
+			policy:   passphrase,
+			terms:    []string{"acme"},
+			expected: output.PolicyOutput{Name: "passphrase", Blocklist: true},
+		},
+		{
+			name:        "policy not found",
+			in:          input.PolicyInput{Name: "missing"},
+			policyErr:   _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			expectedErr: _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+			policies.On("FindByName", mock.Anything, test.in.Name).Return(test.policy, test.policyErr)
+			uc := NewGetPolicyUseCase(policies, blocklistOf(test.terms...), policyPresenterMock{})
+
+			out, err := uc.Execute(context.Background(), test.in)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
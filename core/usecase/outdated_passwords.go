@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/output"
+	"sort"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	OutdatedPasswordsUseCase interface {
+		Execute(context.Context) (output.OutdatedPasswordsOutput, error)
+	}
+
+	outdatedPasswordsUseCase struct {
+		repository       repository.PasswordRepository
+		policyRepository repository.PolicyRepository
+	}
+)
+
+func NewOutdatedPasswordsUseCase(
+	repository repository.PasswordRepository,
+	policyRepository repository.PolicyRepository,
+) OutdatedPasswordsUseCase {
+	return &outdatedPasswordsUseCase{
+		repository:       repository,
+		policyRepository: policyRepository,
+	}
+}
+
+// Execute groups the stored passwords accepted under a policy version other than the
+// current one, including policies that were removed altogether.
+func (u outdatedPasswordsUseCase) Execute(ctx context.Context) (output.OutdatedPasswordsOutput, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Outdated passwords usecase initialized")
+
+	passwords, err := u.repository.FindAll(ctx)
+	if err != nil {
+		return output.OutdatedPasswordsOutput{}, err
+	}
+
+	current := make(map[string]string)
+	groups := make(map[[2]string]*output.OutdatedPasswordGroup)
+	out := output.OutdatedPasswordsOutput{Total: len(passwords), Groups: []output.OutdatedPasswordGroup{}}
+	for _, p := range passwords {
+		name, version := p.Policy().Name, p.Policy().Version()
+		currentVersion, ok := current[name]
+		if !ok {
+			if latest, err := u.policyRepository.FindByName(ctx, name); err == nil {
+				currentVersion = latest.Version()
+			}
+			current[name] = currentVersion
+		}
+		if version == currentVersion {
+			continue
+		}
+
+		out.Outdated++
+		key := [2]string{name, version}
+		if groups[key] == nil {
+			groups[key] = &output.OutdatedPasswordGroup{Policy: name, Version: version, CurrentVersion: currentVersion}
+		}
+		groups[key].Count++
+	}
+
+	for _, group := range groups {
+		out.Groups = append(out.Groups, *group)
+	}
+	sort.Slice(out.Groups, func(i, j int) bool {
+		if out.Groups[i].Policy != out.Groups[j].Policy {
+			return out.Groups[i].Policy < out.Groups[j].Policy
+		}
+		return out.Groups[i].Version < out.Groups[j].Version
+	})
+
+	log.WithFields(logger.Field{"total": out.Total, "outdated": out.Outdated}).Info("Outdated passwords usecase finished")
+	return out, nil
+}
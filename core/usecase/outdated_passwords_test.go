@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutdatedPasswordsUseCase(t *testing.T) {
+	retired := policy.Default()
+	retired.MinLength = 8
+	removed := policy.Policy{Name: "legacy", MinLength: 6, AllowRepeated: true}
+	current, _ := password.New(password.WithPassword("AbTp9!fok"))
+	old, _ := password.New(password.WithPassword("AbTp9!fo"), password.WithPolicy(retired))
+	legacy, _ := password.New(password.WithPassword("abcdef"), password.WithPolicy(removed))
+
+	tt := []struct {
+		name        string
+		stored      []*password.Password
+		findAllErr  error
+		expected    output.OutdatedPasswordsOutput
+		expectedErr error
+	}{
+		{
+			name:   "groups passwords by outdated version",
+			stored: []*password.Password{current, old, old, legacy},
+			expected: output.OutdatedPasswordsOutput{
+				Total:    4,
+				Outdated: 3,
+				Groups: []output.OutdatedPasswordGroup{
+					{Policy: policy.DefaultName, Version: retired.Version(), CurrentVersion: policy.Default().Version(), Count: 2},
+					{Policy: "legacy", Version: removed.Version(), Count: 1},
+				},
+			},
+		},
+		{
+			name:     "nothing outdated",
+			stored:   []*password.Password{current},
+			expected: output.OutdatedPasswordsOutput{Total: 1, Groups: []output.OutdatedPasswordGroup{}},
+		},
+		{
+			name:        "repository error",
+			stored:      []*password.Password{},
+			findAllErr:  errors.New("test"),
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			passwords := &repository.PasswordRepositoryMock{}
+			passwords.On("FindAll", mock.Anything).Return(test.stored, test.findAllErr)
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, policy.DefaultName).Return(policy.Default(), nil)
+			policies.On("FindByName", mock.Anything, "legacy").Return(policy.Policy{}, _errors.NotFoundError{Entity: "Policy", ID: "legacy"})
+			uc := NewOutdatedPasswordsUseCase(passwords, policies)
+
+			out, err := uc.Execute(context.Background())
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package output
+
+type (
+	ComplianceOutput struct {
+		Policy        string               `json:"policy"`
+		PolicyVersion string               `json:"policyVersion"`
+		Blocklist     bool                 `json:"blocklist"`
+		Summary       ComplianceSummary    `json:"summary"`
+		Standards     []ComplianceStandard `json:"standards"`
+	}
+
+	ComplianceSummary struct {
+		Met    int `json:"met"`
+		Gaps   int `json:"gaps"`
+		Manual int `json:"manual"`
+	}
+
+	ComplianceStandard struct {
+		Name     string              `json:"name"`
+		Findings []ComplianceFinding `json:"findings"`
+	}
+
+	ComplianceFinding struct {
+		Reference   string `json:"reference"`
+		Requirement string `json:"requirement"`
+		Status      string `json:"status"`
+		Detail      string `json:"detail,omitempty"`
+	}
+)
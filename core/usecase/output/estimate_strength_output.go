@@ -0,0 +1,8 @@
+package output
+
+type StrengthOutput struct {
+	Score      int     `json:"score"`
+	Entropy    float64 `json:"entropy"`
+	Label      string  `json:"label"`
+	CommonRank int     `json:"commonRank,omitempty"`
+}
@@ -0,0 +1,6 @@
+package output
+
+type GenerateOutput struct {
+	Password string `json:"password"`
+	Policy   string `json:"policy"`
+}
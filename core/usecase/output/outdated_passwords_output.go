@@ -0,0 +1,18 @@
+package output
+
+type (
+	// OutdatedPasswordsOutput counts the stored passwords accepted under a policy
+	// version that is no longer current. It never includes the passwords themselves.
+	OutdatedPasswordsOutput struct {
+		Total    int                     `json:"total"`
+		Outdated int                     `json:"outdated"`
+		Groups   []OutdatedPasswordGroup `json:"groups"`
+	}
+
+	OutdatedPasswordGroup struct {
+		Policy         string `json:"policy"`
+		Version        string `json:"version"`
+		CurrentVersion string `json:"currentVersion,omitempty"`
+		Count          int    `json:"count"`
+	}
+)
@@ -0,0 +1,50 @@
+package output
+
+type (
+	PolicyOutput struct {
+		Name                      string       `json:"name"`
+		Version                   string       `json:"version"`
+		Description               string       `json:"description,omitempty"`
+		Default                   bool         `json:"default"`
+		MinLength                 int          `json:"minLength"`
+		MaxLength                 int          `json:"maxLength"`
+		RequireDigit              bool         `json:"requireDigit"`
+		RequireLower              bool         `json:"requireLower"`
+		RequireUpper              bool         `json:"requireUpper"`
+		RequireSpecial            bool         `json:"requireSpecial"`
+		SpecialChars              string       `json:"specialChars"`
+		AllowRepeated             bool         `json:"allowRepeated"`
+		RejectCommon              bool         `json:"rejectCommon"`
+		Whitespace                string       `json:"whitespace"`
+		MaxConsecutive            int          `json:"maxConsecutive"`
+		MaxOccurrences            int          `json:"maxOccurrences"`
+		MinUnique                 int          `json:"minUnique"`
+		CaseInsensitiveRepetition bool         `json:"caseInsensitiveRepetition"`
+		MinDigits                 int          `json:"minDigits"`
+		MinLower                  int          `json:"minLower"`
+		MinUpper                  int          `json:"minUpper"`
+		MinSpecial                int          `json:"minSpecial"`
+		MinClasses                int          `json:"minClasses"`
+		ASCIIClasses              bool         `json:"asciiClasses"`
+		AnySpecial                bool         `json:"anySpecial"`
+		Blocklist                 bool         `json:"blocklist"`
+		Rules                     []PolicyRule `json:"rules"`
+	}
+
+	PolicyRule struct {
+		Code    string         `json:"code"`
+		Message string         `json:"message"`
+		Params  map[string]any `json:"params,omitempty"`
+	}
+
+	PolicyListOutput struct {
+		Default  string         `json:"default"`
+		Policies []PolicyOutput `json:"policies"`
+	}
+
+	PolicyVersionsOutput struct {
+		Name     string         `json:"name"`
+		Current  string         `json:"current"`
+		Versions []PolicyOutput `json:"versions"`
+	}
+)
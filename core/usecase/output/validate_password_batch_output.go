@@ -0,0 +1,12 @@
+package output
+
+type (
+	PasswordBatchOutput struct {
+		Results []PasswordBatchResult `json:"results"`
+	}
+
+	PasswordBatchResult struct {
+		PasswordOutput
+		Error string `json:"error,omitempty"`
+	}
+)
@@ -1,5 +1,27 @@
 package output
 
-type PasswordOutput struct {
-	IsValid bool `json:"isValid"`
-}
+type (
+	PasswordOutput struct {
+		IsValid       bool        `json:"isValid"`
+		Policy        string      `json:"policy,omitempty"`
+		PolicyVersion string      `json:"policyVersion,omitempty"`
+		Violations    []Violation `json:"violations,omitempty"`
+		Satisfied     []string    `json:"satisfied,omitempty"`
+		Evaluated     *Evaluated  `json:"evaluated,omitempty"`
+	}
+
+	// Evaluated describes the form of the password the rules checked, without the
+	// password itself.
+	Evaluated struct {
+		Whitespace string `json:"whitespace"`
+		Length     int    `json:"length"`
+	}
+
+	// Violation describes a broken rule. Message is written in the language of the
+	// request; Code and Params are the same in every language.
+	Violation struct {
+		Code    string         `json:"code"`
+		Message string         `json:"message"`
+		Params  map[string]any `json:"params,omitempty"`
+	}
+)
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	ListPolicyVersionsUseCase interface {
+		Execute(context.Context, input.PolicyInput) (output.PolicyVersionsOutput, error)
+	}
+
+	GetPolicyVersionUseCase interface {
+		Execute(context.Context, input.PolicyVersionInput) (output.PolicyOutput, error)
+	}
+
+	listPolicyVersionsUseCase struct {
+		policyRepository    repository.PolicyRepository
+		blocklistRepository repository.BlocklistRepository
+		presenter           PolicyPresenter
+	}
+
+	getPolicyVersionUseCase struct {
+		policyRepository    repository.PolicyRepository
+		blocklistRepository repository.BlocklistRepository
+		presenter           PolicyPresenter
+	}
+)
+
+func NewListPolicyVersionsUseCase(
+	policyRepository repository.PolicyRepository,
+	blocklistRepository repository.BlocklistRepository,
+	presenter PolicyPresenter,
+) ListPolicyVersionsUseCase {
+	return &listPolicyVersionsUseCase{
+		policyRepository:    policyRepository,
+		blocklistRepository: blocklistRepository,
+		presenter:           presenter,
+	}
+}
+
+func NewGetPolicyVersionUseCase(
+	policyRepository repository.PolicyRepository,
+	blocklistRepository repository.BlocklistRepository,
+	presenter PolicyPresenter,
+) GetPolicyVersionUseCase {
+	return &getPolicyVersionUseCase{
+		policyRepository:    policyRepository,
+		blocklistRepository: blocklistRepository,
+		presenter:           presenter,
+	}
+}
+
+// Execute lists the current version of a policy followed by its retired ones.
+// Current is empty when the policy only exists in the history.
+func (u listPolicyVersionsUseCase) Execute(ctx context.Context, i input.PolicyInput) (output.PolicyVersionsOutput, error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"policy": i.Name})
+	log.Info("List policy versions usecase initialized")
+
+	versions, err := u.policyRepository.FindVersions(ctx, i.Name)
+	if err != nil {
+		return output.PolicyVersionsOutput{}, err
+	}
+	defaultPolicy, err := u.policyRepository.FindByName(ctx, "")
+	if err != nil {
+		return output.PolicyVersionsOutput{}, err
+	}
+	terms, err := u.blocklistRepository.Find(ctx)
+	if err != nil {
+		return output.PolicyVersionsOutput{}, err
+	}
+
+	out := output.PolicyVersionsOutput{
+		Name:     i.Name,
+		Versions: make([]output.PolicyOutput, 0, len(versions)),
+	}
+	if current, err := u.policyRepository.FindByName(ctx, i.Name); err == nil {
+		out.Current = current.Version()
+	}
+	for _, p := range versions {
+		out.Versions = append(out.Versions, u.presenter.Output(ctx, p, p.Name == defaultPolicy.Name, terms.Len() > 0))
+	}
+
+	log.WithFields(logger.Field{"versions": len(out.Versions)}).Info("List policy versions usecase finished")
+	return out, nil
+}
+
+func (u getPolicyVersionUseCase) Execute(ctx context.Context, i input.PolicyVersionInput) (output.PolicyOutput, error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"policy": i.Name, "version": i.Version})
+	log.Info("Get policy version usecase initialized")
+
+	p, err := u.policyRepository.FindVersion(ctx, i.Name, i.Version)
+	if err != nil {
+		return output.PolicyOutput{}, err
+	}
+	defaultPolicy, err := u.policyRepository.FindByName(ctx, "")
+	if err != nil {
+		return output.PolicyOutput{}, err
+	}
+	terms, err := u.blocklistRepository.Find(ctx)
+	if err != nil {
+		return output.PolicyOutput{}, err
+	}
+
+	log.Info("Get policy version usecase finished")
+	return u.presenter.Output(ctx, p, p.Name == defaultPolicy.Name, terms.Len() > 0), nil
+}
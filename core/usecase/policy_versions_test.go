@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"password-validator/core/domain/policy"
+	_errors "password-validator/core/errors"
+	"password-validator/core/repository"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListPolicyVersionsUseCase(t *testing.T) {
+	retired := policy.Default()
+	retired.MinLength = 8
+	tt := []struct {
+		name        string
+		in          input.PolicyInput
+		versions    []policy.Policy
+		versionsErr error
+		currentErr  error
+		expected    output.PolicyVersionsOutput
+		expectedErr error
+	}{
+		{
+			name:     "current and retired versions",
+			in:       input.PolicyInput{Name: policy.DefaultName},
+			versions: []policy.Policy{policy.Default(), retired},
+			expected: output.PolicyVersionsOutput{
+				Name:    policy.DefaultName,
+				Current: policy.Default().Version(),
+				Versions: []output.PolicyOutput{
+					{Name: policy.DefaultName, Default: true},
+					{Name: policy.DefaultName, Default: true},
+				},
+			},
+		},
+		{
+			name:       "policy only in history",
+			in:         input.PolicyInput{Name: "legacy"},
+			versions:   []policy.Policy{{Name: "legacy", MinLength: 6}},
+			currentErr: _errors.NotFoundError{Entity: "Policy", ID: "legacy"},
+			expected: output.PolicyVersionsOutput{
+				Name:     "legacy",
+				Versions: []output.PolicyOutput{{Name: "legacy"}},
+			},
+		},
+		{
+			name:        "policy not found",
+			in:          input.PolicyInput{Name: "missing"},
+			versions:    []policy.Policy{},
+			versionsErr: _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			expectedErr: _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindVersions", mock.Anything, test.in.Name).Return(test.versions, test.versionsErr)
+			policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+			if len(test.versions) > 0 {
+				policies.On("FindByName", mock.Anything, test.in.Name).Return(test.versions[0], test.currentErr)
+			}
+			uc := NewListPolicyVersionsUseCase(policies, emptyBlocklist(), policyPresenterMock{})
+
+			out, err := uc.Execute(context.Background(), test.in)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
+
+func TestGetPolicyVersionUseCase(t *testing.T) {
+	retired := policy.Default()
+	retired.MinLength = 8
+	tt := []struct {
+		name        string
+		in          input.PolicyVersionInput
+		policy      policy.Policy
+		policyErr   error
+		expected    output.PolicyOutput
+		expectedErr error
+	}{
+		{
+			name:     "retired version",
+			in:       input.PolicyVersionInput{Name: policy.DefaultName, Version: retired.Version()},
+			policy:   retired,
+			expected: output.PolicyOutput{Name: policy.DefaultName, Default: true},
+		},
+		{
+			name:        "version not found",
+			in:          input.PolicyVersionInput{Name: policy.DefaultName, Version: "000000000000"},
+			policyErr:   _errors.NotFoundError{Entity: "Policy", ID: "default@000000000000"},
+			expectedErr: _errors.NotFoundError{Entity: "Policy", ID: "default@000000000000"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindVersion", mock.Anything, test.in.Name, test.in.Version).Return(test.policy, test.policyErr)
+			policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+			uc := NewGetPolicyVersionUseCase(policies, emptyBlocklist(), policyPresenterMock{})
+
+			out, err := uc.Execute(context.Background(), test.in)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
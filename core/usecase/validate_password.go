@@ -2,15 +2,29 @@ package usecase
 
 import (
 	"context"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/locale"
 	"password-validator/core/domain/password"
+	"password-validator/core/domain/tenant"
+	_errors "password-validator/core/errors"
 	"password-validator/core/repository"
 	"password-validator/core/usecase/input"
 	"password-validator/core/usecase/output"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
 	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
 )
 
+const (
+	ShadowAgree               = "agree"
+	ShadowRejectedByCandidate = "rejected_by_candidate"
+	ShadowAcceptedByCandidate = "accepted_by_candidate"
+)
+
 type (
 	ValidatePasswordUseCase interface {
 		Execute(context.Context, input.PasswordInput) (output.PasswordOutput, error)
@@ -21,21 +35,40 @@ type (
 	}
 
 	validatePasswordUseCase struct {
-		ctxTimeout time.Duration
-		repository repository.PasswordRepository
-		presenter  ValidatePasswordPresenter
+		ctxTimeout        time.Duration
+		repository        repository.PasswordRepository
+		policyRepository  repository.PolicyRepository
+		blocklists        repository.BlocklistRepository
+		presenter         ValidatePasswordPresenter
+		validationCounter metric.Int64Counter
+		shadowCounter     metric.Int64Counter
 	}
 )
 
 func NewValidatePasswordUseCase(
 	ctxTimeout time.Duration,
 	repository repository.PasswordRepository,
+	policyRepository repository.PolicyRepository,
+	blocklists repository.BlocklistRepository,
 	presenter ValidatePasswordPresenter,
 ) ValidatePasswordUseCase {
+	meter := otel.Meter("password-validator")
+	validationCounter, _ := meter.Int64Counter(
+		"password.validations",
+		metric.WithDescription("Passwords validated, by tenant, policy and result"),
+	)
+	shadowCounter, _ := meter.Int64Counter(
+		"password.shadow.evaluations",
+		metric.WithDescription("Passwords evaluated against a shadow candidate policy, by outcome"),
+	)
 	return &validatePasswordUseCase{
-		ctxTimeout: ctxTimeout,
-		repository: repository,
-		presenter:  presenter,
+		ctxTimeout:        ctxTimeout,
+		repository:        repository,
+		policyRepository:  policyRepository,
+		blocklists:        blocklists,
+		presenter:         presenter,
+		validationCounter: validationCounter,
+		shadowCounter:     shadowCounter,
 	}
 }
 
@@ -43,11 +76,29 @@ func (u validatePasswordUseCase) Execute(ctx context.Context, i input.PasswordIn
 	log := logger.FromContext(ctx).WithFields(logger.Field{"password": i.Password})
 	log.Info("Validate password usecase initialized")
 
+	policy, err := u.policyRepository.FindByName(ctx, i.Policy)
+	if err != nil {
+		return output.PasswordOutput{}, err
+	}
+
+	terms, err := u.blocklists.Find(ctx)
+	if err != nil {
+		return output.PasswordOutput{}, err
+	}
+
 	p, err := password.New(
 		password.WithPassword(i.Password),
+		password.WithPolicy(policy),
+		password.WithBlocklist(terms),
 	)
+	u.validationCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("tenant", tenant.FromContext(ctx)),
+		attribute.String("policy", policy.Name),
+		attribute.Bool("valid", p.IsValid()),
+	))
+	u.shadow(ctx, p, terms)
 	if err != nil {
-		return output.PasswordOutput{}, err
+		return u.presenter.Output(ctx, p), invalidPassword(ctx, p, err)
 	}
 
 	_ = u.repository.Save(ctx, p)
@@ -55,3 +106,62 @@ func (u validatePasswordUseCase) Execute(ctx context.Context, i input.PasswordIn
 	log.Info("Validate password usecase finished")
 	return u.presenter.Output(ctx, p), nil
 }
+
+// invalidPassword repeats the first violation of p as the error, in the language of
+// the request. Errors without violations are returned as they are.
+func invalidPassword(ctx context.Context, p *password.Password, err error) error {
+	violations := p.Violations()
+	if len(violations) == 0 {
+		return err
+	}
+	language := locale.FromContext(ctx)
+	return _errors.InvalidField{Field: "password", AsIs: password.Message(language, violations[0].Code, violations[0].Params), Language: language}
+}
+
+// shadow evaluates the password against the candidate policy configured for its
+// policy, if any, and records whether both agree. The outcome never reaches the
+// response. Evaluation is cheap and CPU bound, so it runs inline rather than in a
+// goroutine.
+func (u validatePasswordUseCase) shadow(ctx context.Context, active *password.Password, terms blocklist.Blocklist) {
+	candidate, err := u.policyRepository.FindShadow(ctx, active.Policy().Name)
+	if err != nil {
+		return
+	}
+
+	shadowed, _ := password.New(
+		password.WithPassword(active.Password()),
+		password.WithPolicy(candidate),
+		password.WithBlocklist(terms),
+	)
+	outcome := ShadowAgree
+	switch {
+	case active.IsValid() && !shadowed.IsValid():
+		outcome = ShadowRejectedByCandidate
+	case !active.IsValid() && shadowed.IsValid():
+		outcome = ShadowAcceptedByCandidate
+	}
+
+	u.shadowCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("tenant", tenant.FromContext(ctx)),
+		attribute.String("policy", active.Policy().Name),
+		attribute.String("candidate", candidate.Name),
+		attribute.String("outcome", outcome),
+	))
+	if outcome == ShadowAgree {
+		return
+	}
+
+	var violations []string
+	for _, v := range shadowed.Violations() {
+		violations = append(violations, v.Code)
+	}
+	logger.FromContext(ctx).WithFields(logger.Field{
+		"tenant":              tenant.FromContext(ctx),
+		"policy":              active.Policy().Name,
+		"policyVersion":       active.Policy().Version(),
+		"candidate":           candidate.Name,
+		"candidateVersion":    candidate.Version(),
+		"outcome":             outcome,
+		"candidateViolations": violations,
+	}).Info("Shadow policy disagreement")
+}
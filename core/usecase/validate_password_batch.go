@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	constants "password-validator/core/utils"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+type (
+	ValidatePasswordBatchUseCase interface {
+		Execute(context.Context, input.PasswordBatchInput) (output.PasswordBatchOutput, error)
+	}
+
+	validatePasswordBatchUseCase struct {
+		validatePasswordUseCase ValidatePasswordUseCase
+	}
+)
+
+func NewValidatePasswordBatchUseCase(
+	validatePasswordUseCase ValidatePasswordUseCase,
+) ValidatePasswordBatchUseCase {
+	return &validatePasswordBatchUseCase{
+		validatePasswordUseCase: validatePasswordUseCase,
+	}
+}
+
+// Execute validates every password on its own, so an invalid password or an unknown
+// policy only affects its own result.
+func (u validatePasswordBatchUseCase) Execute(ctx context.Context, i input.PasswordBatchInput) (output.PasswordBatchOutput, error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"size": len(i.Passwords)})
+	log.Info("Validate password batch usecase initialized")
+
+	if len(i.Passwords) > constants.MAX_BATCH_SIZE {
+		return output.PasswordBatchOutput{}, _errors.InvalidField{
+			Field: "passwords",
+			AsIs:  fmt.Sprintf("Must have at most %d passwords", constants.MAX_BATCH_SIZE),
+		}
+	}
+
+	results := make([]output.PasswordBatchResult, 0, len(i.Passwords))
+	for _, p := range i.Passwords {
+		out, err := u.validatePasswordUseCase.Execute(ctx, p)
+		result := output.PasswordBatchResult{PasswordOutput: out}
+		if err != nil && !errors.As(err, &_errors.InvalidField{}) {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	log.Info("Validate password batch usecase finished")
+	return output.PasswordBatchOutput{Results: results}, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type validatePasswordUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *validatePasswordUseCaseMock) Execute(ctx context.Context, i input.PasswordInput) (output.PasswordOutput, error) {
+	ret := m.Called(ctx, i)
+	return ret.Get(0).(output.PasswordOutput), ret.Error(1)
+}
+
+func TestValidatePasswordBatchUseCase(t *testing.T) {
+	valid := input.PasswordInput{Password: "AbTp9!fok"}
+	invalid := input.PasswordInput{Password: "AbTp9!foA"}
+	unknownPolicy := input.PasswordInput{Password: "AbTp9!fok", Policy: "missing"}
+
+	uc := &validatePasswordUseCaseMock{}
+	uc.On("Execute", mock.Anything, valid).Return(output.PasswordOutput{IsValid: true}, nil)
+	uc.On("Execute", mock.Anything, invalid).Return(output.PasswordOutput{Violations: []output.Violation{{Code: "unique_characters"}}}, _errors.InvalidField{Field: "password"})
+	uc.On("Execute", mock.Anything, unknownPolicy).Return(output.PasswordOutput{}, _errors.NotFoundError{Entity: "Policy", ID: "missing"})
+	batch := NewValidatePasswordBatchUseCase(uc)
+
+	out, err := batch.Execute(context.Background(), input.PasswordBatchInput{Passwords: []input.PasswordInput{valid, invalid, unknownPolicy}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, output.PasswordBatchOutput{Results: []output.PasswordBatchResult{
+		{PasswordOutput: output.PasswordOutput{IsValid: true}},
+		{PasswordOutput: output.PasswordOutput{Violations: []output.Violation{{Code: "unique_characters"}}}},
+		{Error: "Policy not found with ID 'missing'"},
+	}}, out)
+
+	_, err = batch.Execute(context.Background(), input.PasswordBatchInput{Passwords: make([]input.PasswordInput, 1001)})
+	assert.Equal(t, _errors.InvalidField{Field: "passwords", AsIs: "Must have at most 1000 passwords"}, err)
+}
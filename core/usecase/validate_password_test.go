@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"context"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/locale"
 	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/tenant"
 	_errors "password-validator/core/errors"
 	"password-validator/core/repository"
 	"password-validator/core/usecase/input"
@@ -12,6 +16,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 type (
@@ -20,6 +28,8 @@ type (
 		in         any
 		repoReturn any
 		repoErr    error
+		policyErr  error
+		terms      []string
 		out        any
 		err        error
 	}
@@ -48,6 +58,16 @@ func TestValidatePasswordUseCase(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "policy not found",
+			in: input.PasswordInput{
+				Password: "AbTp9!fok",
+				Policy:   "missing",
+			},
+			policyErr: _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+			out:       output.PasswordOutput{},
+			err:       _errors.NotFoundError{Entity: "Policy", ID: "missing"},
+		},
 		{
 			name: "validation error",
 			in: input.PasswordInput{
@@ -55,7 +75,16 @@ func TestValidatePasswordUseCase(t *testing.T) {
 			},
 			repoErr: nil,
 			out:     output.PasswordOutput{},
-			err:     _errors.InvalidField{Field: "password", AsIs: "Must not contain repeated characters (excluding spaces)"},
+			err:     _errors.InvalidField{Field: "password", AsIs: "Must not contain repeated characters (excluding spaces)", Language: locale.English},
+		},
+		{
+			name: "blocklisted term",
+			in: input.PasswordInput{
+				Password: "Acme9!fok#",
+			},
+			terms: []string{"acme"},
+			out:   output.PasswordOutput{},
+			err:   _errors.InvalidField{Field: "password", AsIs: "Must not contain names or terms related to the organization", Language: locale.English},
 		},
 	}
 
@@ -63,14 +92,159 @@ func TestValidatePasswordUseCase(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			repo := &repository.PasswordRepositoryMock{}
 			repo.On("Save", mock.Anything, mock.Anything).Return(test.repoErr)
-			uc := NewValidatePasswordUseCase(10*time.Second, repo, &validatePasswordPresenterMock{})
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, test.in.(input.PasswordInput).Policy).Return(policy.Default(), test.policyErr)
+			policies.On("FindShadow", mock.Anything, policy.DefaultName).Return(policy.Policy{}, _errors.NotFoundError{Entity: "Shadow policy", ID: policy.DefaultName})
+			blocklists := &repository.BlocklistRepositoryMock{}
+			blocklists.On("Find", mock.Anything).Return(blocklist.New(test.terms, blocklist.DefaultSubstringLength), nil)
+			uc := NewValidatePasswordUseCase(10*time.Second, repo, policies, blocklists, &validatePasswordPresenterMock{})
 			out, err := uc.Execute(context.Background(), test.in.(input.PasswordInput))
 			if test.err == nil {
 				assert.NoError(t, err)
 				assert.Equal(t, test.out.(output.PasswordOutput).IsValid, out.IsValid)
 			} else {
 				assert.Equal(t, test.err, err)
+				assert.False(t, out.IsValid)
 			}
 		})
 	}
 }
+
+func TestValidatePasswordUseCaseLanguage(t *testing.T) {
+	policies := &repository.PolicyRepositoryMock{}
+	policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+	policies.On("FindShadow", mock.Anything, policy.DefaultName).Return(policy.Policy{}, _errors.NotFoundError{Entity: "Shadow policy", ID: policy.DefaultName})
+	blocklists := &repository.BlocklistRepositoryMock{}
+	blocklists.On("Find", mock.Anything).Return(blocklist.Blocklist{}, nil)
+	uc := NewValidatePasswordUseCase(10*time.Second, &repository.PasswordRepositoryMock{}, policies, blocklists, &validatePasswordPresenterMock{})
+
+	_, err := uc.Execute(locale.NewContext(context.Background(), locale.Spanish), input.PasswordInput{Password: "AbTp9!foA"})
+
+	assert.Equal(t, _errors.InvalidField{Field: "password", AsIs: "No debe contener caracteres repetidos (sin contar espacios)", Language: locale.Spanish}, err)
+	assert.Equal(t, "El campo [password] no es válido. No debe contener caracteres repetidos (sin contar espacios).", err.Error())
+}
+
+func TestValidatePasswordUseCaseShadow(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+
+	strict := policy.Default()
+	strict.Name = "strict"
+	strict.MinLength = 12
+	relaxed := policy.Policy{Name: "relaxed", MinLength: 6, AllowRepeated: true}
+	tt := []struct {
+		name            string
+		password        string
+		candidate       policy.Policy
+		expectedValid   bool
+		expectedOutcome string
+	}{
+		{
+			name:            "candidate rejects what the active policy accepts",
+			password:        "AbTp9!fok",
+			candidate:       strict,
+			expectedValid:   true,
+			expectedOutcome: ShadowRejectedByCandidate,
+		},
+		{
+			name:            "candidate accepts what the active policy rejects",
+			password:        "AbTp9!foA",
+			candidate:       relaxed,
+			expectedOutcome: ShadowAcceptedByCandidate,
+		},
+		{
+			name:            "both agree",
+			password:        "AbTp9!fokXyZ",
+			candidate:       strict,
+			expectedValid:   true,
+			expectedOutcome: ShadowAgree,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			repo := &repository.PasswordRepositoryMock{}
+			repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+			policies := &repository.PolicyRepositoryMock{}
+			policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+			policies.On("FindShadow", mock.Anything, policy.DefaultName).Return(test.candidate, nil)
+			uc := NewValidatePasswordUseCase(10*time.Second, repo, policies, emptyBlocklist(), &validatePasswordPresenterMock{})
+
+			out, _ := uc.Execute(context.Background(), input.PasswordInput{Password: test.password})
+
+			assert.Equal(t, test.expectedValid, out.IsValid)
+			var rm metricdata.ResourceMetrics
+			assert.NoError(t, reader.Collect(context.Background(), &rm))
+			assert.Equal(t, int64(1), shadowEvaluations(rm, test.candidate.Name, test.expectedOutcome))
+		})
+	}
+}
+
+func TestValidatePasswordUseCaseTenantMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+
+	repo := &repository.PasswordRepositoryMock{}
+	repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	policies := &repository.PolicyRepositoryMock{}
+	policies.On("FindByName", mock.Anything, "").Return(policy.Default(), nil)
+	policies.On("FindShadow", mock.Anything, policy.DefaultName).Return(policy.Policy{}, _errors.NotFoundError{Entity: "Shadow policy", ID: policy.DefaultName})
+	uc := NewValidatePasswordUseCase(10*time.Second, repo, policies, emptyBlocklist(), &validatePasswordPresenterMock{})
+
+	uc.Execute(tenant.NewContext(context.Background(), "cards"), input.PasswordInput{Password: "AbTp9!fok"})
+	uc.Execute(context.Background(), input.PasswordInput{Password: "AbTp9!foA"})
+
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, int64(1), validations(rm, "cards", true))
+	assert.Equal(t, int64(0), validations(rm, "cards", false))
+	assert.Equal(t, int64(1), validations(rm, tenant.Default, false))
+}
+
+func emptyBlocklist() *repository.BlocklistRepositoryMock {
+	blocklists := &repository.BlocklistRepositoryMock{}
+	blocklists.On("Find", mock.Anything).Return(blocklist.Blocklist{}, nil)
+	return blocklists
+}
+
+func validations(rm metricdata.ResourceMetrics, id string, valid bool) int64 {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if m.Name != "password.validations" || !ok {
+				continue
+			}
+			for _, dp := range sum.DataPoints {
+				tn, _ := dp.Attributes.Value(attribute.Key("tenant"))
+				v, _ := dp.Attributes.Value(attribute.Key("valid"))
+				if tn.AsString() == id && v.AsBool() == valid {
+					return dp.Value
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func shadowEvaluations(rm metricdata.ResourceMetrics, candidate, outcome string) int64 {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if m.Name != "password.shadow.evaluations" || !ok {
+				continue
+			}
+			for _, dp := range sum.DataPoints {
+				c, _ := dp.Attributes.Value(attribute.Key("candidate"))
+				o, _ := dp.Attributes.Value(attribute.Key("outcome"))
+				if c.AsString() == candidate && o.AsString() == outcome {
+					return dp.Value
+				}
+			}
+		}
+	}
+	return 0
+}
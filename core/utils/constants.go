@@ -1,5 +1,6 @@
 package constants
 
 const (
-	SPECIAL_CHARS = "!@#$%^&*()-+"
+	SPECIAL_CHARS  = "!@#$%^&*()-+"
+	MAX_BATCH_SIZE = 1000
 )
@@ -1,9 +1,21 @@
 package config
 
 import (
+	"fmt"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/password"
+	constants "password-validator/core/utils"
+
 	"github.com/spf13/viper"
 )
 
+// batchEntryOverheadBytes is the room each batch entry takes besides its password:
+// JSON syntax, escapes and a policy name.
+const batchEntryOverheadBytes = 1 << 10
+
+// DefaultMaxBodyBytes fits a full batch of passwords of the maximum input size.
+const DefaultMaxBodyBytes = constants.MAX_BATCH_SIZE * (password.MaxInputBytes + batchEntryOverheadBytes)
+
 var C = &AppConfig{}
 
 type AppConfig struct {
@@ -12,7 +24,19 @@ type AppConfig struct {
 	Environment    string `mapstructure:"environment"`
 	LoggingLevel   string `mapstructure:"logging_level"`
 	HttpServerPort string `mapstructure:"http_server_port"`
+	GrpcServerPort string `mapstructure:"grpc_server_port"`
 	ServerTimeout  string `mapstructure:"server_timeout"`
+	PolicyFile     string `mapstructure:"policy_file"`
+	TenantsFile    string `mapstructure:"tenants_file"`
+	BlocklistFile  string `mapstructure:"blocklist_file"`
+
+	BlocklistSubstringLength int  `mapstructure:"blocklist_substring_length"`
+	BlocklistConfusables     bool `mapstructure:"blocklist_confusables"`
+
+	// MaxBodyBytes bounds HTTP bodies and gRPC messages. Zero disables the limit.
+	MaxBodyBytes int64 `mapstructure:"max_body_bytes"`
+
+	LegacyErrors bool `mapstructure:"legacy_errors"`
 }
 
 func Load() error {
@@ -22,15 +46,29 @@ func Load() error {
 	v.BindEnv("environment")
 	v.SetDefault("logging_level", "info")
 	v.SetDefault("http_server_port", "8080")
+	v.SetDefault("grpc_server_port", "9090")
 	v.SetDefault("server_timeout", "10")
+	v.SetDefault("blocklist_substring_length", blocklist.DefaultSubstringLength)
+	v.SetDefault("max_body_bytes", DefaultMaxBodyBytes)
 	v.BindEnv("logging_level")
 	v.BindEnv("http_server_port")
+	v.BindEnv("grpc_server_port")
 	v.BindEnv("server_timeout")
+	v.BindEnv("policy_file")
+	v.BindEnv("tenants_file")
+	v.BindEnv("blocklist_file")
+	v.BindEnv("blocklist_substring_length")
+	v.BindEnv("blocklist_confusables")
+	v.BindEnv("max_body_bytes")
+	v.BindEnv("legacy_errors")
 
 	v.AutomaticEnv()
 	if err := v.Unmarshal(C); err != nil {
 		return err
 	}
+	if C.MaxBodyBytes < 0 {
+		return fmt.Errorf("max_body_bytes must not be negative, use 0 for no limit: %d", C.MaxBodyBytes)
+	}
 
 	return nil
 }
@@ -0,0 +1,145 @@
+package container
+
+import (
+	"context"
+	"password-validator/adapter/presenter"
+	"password-validator/adapter/repository"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/tenant"
+	"password-validator/core/usecase"
+	appConfig "password-validator/infrastructure/config"
+	"time"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+// Container holds the use cases shared by every server, so the HTTP and gRPC APIs
+// validate against the same policies and repository. Tenants resolves the tenant of
+// each request, and PolicyRepositories and BlocklistRepositories hold the policies and
+// blocklist of each tenant so they can be reloaded while the servers run.
+type Container struct {
+	Tenants                      tenant.Registry
+	PolicyRepositories           map[string]*repository.PolicyRepository
+	BlocklistRepositories        map[string]*repository.BlocklistRepository
+	PasswordRepository           *repository.PasswordRepository
+	ValidatePasswordUseCase      usecase.ValidatePasswordUseCase
+	EvaluatePasswordUseCase      usecase.EvaluatePasswordUseCase
+	ValidatePasswordBatchUseCase usecase.ValidatePasswordBatchUseCase
+	EstimateStrengthUseCase      usecase.EstimateStrengthUseCase
+	GeneratePasswordUseCase      usecase.GeneratePasswordUseCase
+	ListPoliciesUseCase          usecase.ListPoliciesUseCase
+	GetPolicyUseCase             usecase.GetPolicyUseCase
+	ListPolicyVersionsUseCase    usecase.ListPolicyVersionsUseCase
+	GetPolicyVersionUseCase      usecase.GetPolicyVersionUseCase
+	OutdatedPasswordsUseCase     usecase.OutdatedPasswordsUseCase
+	ComplianceReportUseCase      usecase.ComplianceReportUseCase
+}
+
+// New builds the use cases for the given tenants. Tenants missing from policies,
+// the default one included, use the default policy, and tenants missing from
+// blocklists have no blocklist.
+func New(ctxTimeout time.Duration, tenants tenant.Registry, policies map[string]policy.Document, blocklists map[string]blocklist.Blocklist) *Container {
+	policyRepositories := make(map[string]*repository.PolicyRepository)
+	blocklistRepositories := make(map[string]*repository.BlocklistRepository)
+	for _, id := range tenants.IDs() {
+		document, ok := policies[id]
+		if !ok {
+			document = policy.DefaultDocument()
+		}
+		policyRepositories[id] = repository.NewPolicyRepository(document)
+		blocklistRepositories[id] = repository.NewBlocklistRepository(blocklists[id])
+	}
+
+	passwordRepository := repository.NewPasswordRepository()
+	policyRepository := repository.NewTenantPolicyRepository(policyRepositories)
+	blocklistRepository := repository.NewTenantBlocklistRepository(blocklistRepositories)
+	validatePasswordUseCase := usecase.NewValidatePasswordUseCase(ctxTimeout, passwordRepository, policyRepository, blocklistRepository, presenter.NewValidatePasswordPresenter())
+	return &Container{
+		Tenants:                      tenants,
+		PolicyRepositories:           policyRepositories,
+		BlocklistRepositories:        blocklistRepositories,
+		PasswordRepository:           passwordRepository,
+		ValidatePasswordUseCase:      validatePasswordUseCase,
+		EvaluatePasswordUseCase:      usecase.NewEvaluatePasswordUseCase(policyRepository, blocklistRepository, presenter.NewValidatePasswordPresenter()),
+		ValidatePasswordBatchUseCase: usecase.NewValidatePasswordBatchUseCase(validatePasswordUseCase),
+		EstimateStrengthUseCase:      usecase.NewEstimateStrengthUseCase(presenter.NewEstimateStrengthPresenter()),
+		GeneratePasswordUseCase:      usecase.NewGeneratePasswordUseCase(policyRepository, presenter.NewGeneratePasswordPresenter()),
+		ListPoliciesUseCase:          usecase.NewListPoliciesUseCase(policyRepository, blocklistRepository, presenter.NewPolicyPresenter()),
+		GetPolicyUseCase:             usecase.NewGetPolicyUseCase(policyRepository, blocklistRepository, presenter.NewPolicyPresenter()),
+		ListPolicyVersionsUseCase:    usecase.NewListPolicyVersionsUseCase(policyRepository, blocklistRepository, presenter.NewPolicyPresenter()),
+		GetPolicyVersionUseCase:      usecase.NewGetPolicyVersionUseCase(policyRepository, blocklistRepository, presenter.NewPolicyPresenter()),
+		OutdatedPasswordsUseCase:     usecase.NewOutdatedPasswordsUseCase(passwordRepository, policyRepository),
+		ComplianceReportUseCase:      usecase.NewComplianceReportUseCase(policyRepository, blocklistRepository, presenter.NewCompliancePresenter()),
+	}
+}
+
+func Init() *Container {
+	log := logger.FromContext(context.Background())
+	duration, err := time.ParseDuration(appConfig.C.ServerTimeout + "s")
+	if err != nil {
+		log.Fatal("error parsing duration to time duration", err)
+	}
+
+	var tenants tenant.Registry
+	if appConfig.C.TenantsFile != "" {
+		tenants, err = tenant.LoadFile(appConfig.C.TenantsFile)
+		if err != nil {
+			log.Fatal("error loading tenants file", err)
+		}
+	}
+
+	policies := make(map[string]policy.Document)
+	files := PolicyFiles(tenants)
+	for id, file := range files {
+		policies[id], err = policy.LoadFile(file)
+		if err != nil {
+			log.WithFields(logger.Field{"tenant": id}).Fatal("error loading policy file", err)
+		}
+	}
+
+	blocklists := make(map[string]blocklist.Blocklist)
+	for id, file := range BlocklistFiles(tenants) {
+		terms, err := blocklist.LoadFile(file, appConfig.C.BlocklistSubstringLength)
+		if err != nil {
+			log.WithFields(logger.Field{"tenant": id}).Fatal("error loading blocklist file", err)
+		}
+		if appConfig.C.BlocklistConfusables {
+			terms = terms.WithConfusables()
+		}
+		blocklists[id] = terms
+	}
+
+	log.WithFields(logger.Field{"tenants": tenants.IDs()}).Info("Use cases have been successfully configured.")
+	return New(duration, tenants, policies, blocklists)
+}
+
+// PolicyFiles returns the policy file of each tenant that has one: POLICY_FILE for
+// the default tenant and the tenants file entries for the others.
+func PolicyFiles(tenants tenant.Registry) map[string]string {
+	files := make(map[string]string)
+	if appConfig.C.PolicyFile != "" {
+		files[tenant.Default] = appConfig.C.PolicyFile
+	}
+	for _, t := range tenants.Tenants {
+		if t.PolicyFile != "" {
+			files[t.ID] = t.PolicyFile
+		}
+	}
+	return files
+}
+
+// BlocklistFiles returns the blocklist file of each tenant that has one:
+// BLOCKLIST_FILE for the default tenant and the tenants file entries for the others.
+func BlocklistFiles(tenants tenant.Registry) map[string]string {
+	files := make(map[string]string)
+	if appConfig.C.BlocklistFile != "" {
+		files[tenant.Default] = appConfig.C.BlocklistFile
+	}
+	for _, t := range tenants.Tenants {
+		if t.BlocklistFile != "" {
+			files[t.ID] = t.BlocklistFile
+		}
+	}
+	return files
+}
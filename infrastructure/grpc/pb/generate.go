@@ -0,0 +1,4 @@
+// Package pb holds the Go code generated from ../proto/password_validator.proto.
+package pb
+
+//go:generate protoc -I ../proto --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative password_validator.proto
@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: password_validator.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidateRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// Policy name. Empty selects the default policy.
+	Policy        string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_password_validator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type Violation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Violation) Reset() {
+	*x = Violation{}
+	mi := &file_password_validator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation) ProtoMessage() {}
+
+func (x *Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Violation.ProtoReflect.Descriptor instead.
+func (*Violation) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Violation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Violation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	IsValid    bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Policy     string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Violations []*Violation           `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+	// Hash of the policy rules the password was checked against.
+	PolicyVersion string `protobuf:"bytes,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_password_validator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidateResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetViolations() []*Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
+}
+
+type ValidateBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*ValidateRequest     `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateBatchRequest) Reset() {
+	*x = ValidateBatchRequest{}
+	mi := &file_password_validator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateBatchRequest) ProtoMessage() {}
+
+func (x *ValidateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateBatchRequest.ProtoReflect.Descriptor instead.
+func (*ValidateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidateBatchRequest) GetRequests() []*ValidateRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ValidateBatchResult struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	IsValid    bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Policy     string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Violations []*Violation           `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+	// Set when the password could not be validated, e.g. unknown policy.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	PolicyVersion string `protobuf:"bytes,5,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateBatchResult) Reset() {
+	*x = ValidateBatchResult{}
+	mi := &file_password_validator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateBatchResult) ProtoMessage() {}
+
+func (x *ValidateBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateBatchResult.ProtoReflect.Descriptor instead.
+func (*ValidateBatchResult) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidateBatchResult) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateBatchResult) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *ValidateBatchResult) GetViolations() []*Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ValidateBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ValidateBatchResult) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
+}
+
+type ValidateBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ValidateBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateBatchResponse) Reset() {
+	*x = ValidateBatchResponse{}
+	mi := &file_password_validator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateBatchResponse) ProtoMessage() {}
+
+func (x *ValidateBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateBatchResponse.ProtoReflect.Descriptor instead.
+func (*ValidateBatchResponse) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidateBatchResponse) GetResults() []*ValidateBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type StrengthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrengthRequest) Reset() {
+	*x = StrengthRequest{}
+	mi := &file_password_validator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrengthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrengthRequest) ProtoMessage() {}
+
+func (x *StrengthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrengthRequest.ProtoReflect.Descriptor instead.
+func (*StrengthRequest) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StrengthRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type StrengthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From 0 (very weak) to 4 (very strong).
+	Score         int32   `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Entropy       float64 `protobuf:"fixed64,2,opt,name=entropy,proto3" json:"entropy,omitempty"`
+	Label         string  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrengthResponse) Reset() {
+	*x = StrengthResponse{}
+	mi := &file_password_validator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrengthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrengthResponse) ProtoMessage() {}
+
+func (x *StrengthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrengthResponse.ProtoReflect.Descriptor instead.
+func (*StrengthResponse) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StrengthResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *StrengthResponse) GetEntropy() float64 {
+	if x != nil {
+		return x.Entropy
+	}
+	return 0
+}
+
+func (x *StrengthResponse) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type GenerateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Policy name. Empty selects the default policy.
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Zero picks a length suitable for the policy.
+	Length        int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_password_validator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GenerateRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GenerateRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type GenerateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Policy        string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateResponse) Reset() {
+	*x = GenerateResponse{}
+	mi := &file_password_validator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateResponse) ProtoMessage() {}
+
+func (x *GenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_password_validator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateResponse.ProtoReflect.Descriptor instead.
+func (*GenerateResponse) Descriptor() ([]byte, []int) {
+	return file_password_validator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GenerateResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GenerateResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+var File_password_validator_proto protoreflect.FileDescriptor
+
+const file_password_validator_proto_rawDesc = "" +
+	"\n" +
+	"\x18password_validator.proto\x12\x14passwordvalidator.v1\"E\n" +
+	"\x0fValidateRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\"9\n" +
+	"\tViolation\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xad\x01\n" +
+	"\x10ValidateResponse\x12\x19\n" +
+	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12?\n" +
+	"\n" +
+	"violations\x18\x03 \x03(\v2\x1f.passwordvalidator.v1.ViolationR\n" +
+	"violations\x12%\n" +
+	"\x0epolicy_version\x18\x04 \x01(\tR\rpolicyVersion\"Y\n" +
+	"\x14ValidateBatchRequest\x12A\n" +
+	"\brequests\x18\x01 \x03(\v2%.passwordvalidator.v1.ValidateRequestR\brequests\"\xc6\x01\n" +
+	"\x13ValidateBatchResult\x12\x19\n" +
+	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12?\n" +
+	"\n" +
+	"violations\x18\x03 \x03(\v2\x1f.passwordvalidator.v1.ViolationR\n" +
+	"violations\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12%\n" +
+	"\x0epolicy_version\x18\x05 \x01(\tR\rpolicyVersion\"\\\n" +
+	"\x15ValidateBatchResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).passwordvalidator.v1.ValidateBatchResultR\aresults\"-\n" +
+	"\x0fStrengthRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"X\n" +
+	"\x10StrengthResponse\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12\x18\n" +
+	"\aentropy\x18\x02 \x01(\x01R\aentropy\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"A\n" +
+	"\x0fGenerateRequest\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\"F\n" +
+	"\x10GenerateResponse\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy2\x8e\x03\n" +
+	"\x11PasswordValidator\x12Y\n" +
+	"\bValidate\x12%.passwordvalidator.v1.ValidateRequest\x1a&.passwordvalidator.v1.ValidateResponse\x12h\n" +
+	"\rValidateBatch\x12*.passwordvalidator.v1.ValidateBatchRequest\x1a+.passwordvalidator.v1.ValidateBatchResponse\x12Y\n" +
+	"\bStrength\x12%.passwordvalidator.v1.StrengthRequest\x1a&.passwordvalidator.v1.StrengthResponse\x12Y\n" +
+	"\bGenerate\x12%.passwordvalidator.v1.GenerateRequest\x1a&.passwordvalidator.v1.GenerateResponseB+Z)password-validator/infrastructure/grpc/pbb\x06proto3"
+
+var (
+	file_password_validator_proto_rawDescOnce sync.Once
+	file_password_validator_proto_rawDescData []byte
+)
+
+func file_password_validator_proto_rawDescGZIP() []byte {
+	file_password_validator_proto_rawDescOnce.Do(func() {
+		file_password_validator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_password_validator_proto_rawDesc), len(file_password_validator_proto_rawDesc)))
+	})
+	return file_password_validator_proto_rawDescData
+}
+
+var file_password_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_password_validator_proto_goTypes = []any{
+	(*ValidateRequest)(nil),       // 0: passwordvalidator.v1.ValidateRequest
+	(*Violation)(nil),             // 1: passwordvalidator.v1.Violation
+	(*ValidateResponse)(nil),      // 2: passwordvalidator.v1.ValidateResponse
+	(*ValidateBatchRequest)(nil),  // 3: passwordvalidator.v1.ValidateBatchRequest
+	(*ValidateBatchResult)(nil),   // 4: passwordvalidator.v1.ValidateBatchResult
+	(*ValidateBatchResponse)(nil), // 5: passwordvalidator.v1.ValidateBatchResponse
+	(*StrengthRequest)(nil),       // 6: passwordvalidator.v1.StrengthRequest
+	(*StrengthResponse)(nil),      // 7: passwordvalidator.v1.StrengthResponse
+	(*GenerateRequest)(nil),       // 8: passwordvalidator.v1.GenerateRequest
+	(*GenerateResponse)(nil),      // 9: passwordvalidator.v1.GenerateResponse
+}
+var file_password_validator_proto_depIdxs = []int32{
+	1, // 0: passwordvalidator.v1.ValidateResponse.violations:type_name -> passwordvalidator.v1.Violation
+	0, // 1: passwordvalidator.v1.ValidateBatchRequest.requests:type_name -> passwordvalidator.v1.ValidateRequest
+	1, // 2: passwordvalidator.v1.ValidateBatchResult.violations:type_name -> passwordvalidator.v1.Violation
+	4, // 3: passwordvalidator.v1.ValidateBatchResponse.results:type_name -> passwordvalidator.v1.ValidateBatchResult
+	0, // 4: passwordvalidator.v1.PasswordValidator.Validate:input_type -> passwordvalidator.v1.ValidateRequest
+	3, // 5: passwordvalidator.v1.PasswordValidator.ValidateBatch:input_type -> passwordvalidator.v1.ValidateBatchRequest
+	6, // 6: passwordvalidator.v1.PasswordValidator.Strength:input_type -> passwordvalidator.v1.StrengthRequest
+	8, // 7: passwordvalidator.v1.PasswordValidator.Generate:input_type -> passwordvalidator.v1.GenerateRequest
+	2, // 8: passwordvalidator.v1.PasswordValidator.Validate:output_type -> passwordvalidator.v1.ValidateResponse
+	5, // 9: passwordvalidator.v1.PasswordValidator.ValidateBatch:output_type -> passwordvalidator.v1.ValidateBatchResponse
+	7, // 10: passwordvalidator.v1.PasswordValidator.Strength:output_type -> passwordvalidator.v1.StrengthResponse
+	9, // 11: passwordvalidator.v1.PasswordValidator.Generate:output_type -> passwordvalidator.v1.GenerateResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_password_validator_proto_init() }
+func file_password_validator_proto_init() {
+	if File_password_validator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_password_validator_proto_rawDesc), len(file_password_validator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_password_validator_proto_goTypes,
+		DependencyIndexes: file_password_validator_proto_depIdxs,
+		MessageInfos:      file_password_validator_proto_msgTypes,
+	}.Build()
+	File_password_validator_proto = out.File
+	file_password_validator_proto_goTypes = nil
+	file_password_validator_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: password_validator.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PasswordValidator_Validate_FullMethodName      = "/passwordvalidator.v1.PasswordValidator/Validate"
+	PasswordValidator_ValidateBatch_FullMethodName = "/passwordvalidator.v1.PasswordValidator/ValidateBatch"
+	PasswordValidator_Strength_FullMethodName      = "/passwordvalidator.v1.PasswordValidator/Strength"
+	PasswordValidator_Generate_FullMethodName      = "/passwordvalidator.v1.PasswordValidator/Generate"
+)
+
+// PasswordValidatorClient is the client API for PasswordValidator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PasswordValidator exposes the same use cases as the HTTP API.
+type PasswordValidatorClient interface {
+	// Validate checks a password against a policy. Invalid passwords fail with
+	// INVALID_ARGUMENT and a google.rpc.BadRequest detail listing every violation.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// ValidateBatch checks several passwords at once. Each password gets its own
+	// result, so one invalid password does not fail the whole batch.
+	ValidateBatch(ctx context.Context, in *ValidateBatchRequest, opts ...grpc.CallOption) (*ValidateBatchResponse, error)
+	// Strength estimates how hard a password is to guess.
+	Strength(ctx context.Context, in *StrengthRequest, opts ...grpc.CallOption) (*StrengthResponse, error)
+	// Generate returns a random password that satisfies a policy.
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
+}
+
+type passwordValidatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPasswordValidatorClient(cc grpc.ClientConnInterface) PasswordValidatorClient {
+	return &passwordValidatorClient{cc}
+}
+
+func (c *passwordValidatorClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, PasswordValidator_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordValidatorClient) ValidateBatch(ctx context.Context, in *ValidateBatchRequest, opts ...grpc.CallOption) (*ValidateBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateBatchResponse)
+	err := c.cc.Invoke(ctx, PasswordValidator_ValidateBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordValidatorClient) Strength(ctx context.Context, in *StrengthRequest, opts ...grpc.CallOption) (*StrengthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrengthResponse)
+	err := c.cc.Invoke(ctx, PasswordValidator_Strength_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordValidatorClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateResponse)
+	err := c.cc.Invoke(ctx, PasswordValidator_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PasswordValidatorServer is the server API for PasswordValidator service.
+// All implementations must embed UnimplementedPasswordValidatorServer
+// for forward compatibility.
+//
+// PasswordValidator exposes the same use cases as the HTTP API.
+type PasswordValidatorServer interface {
+	// Validate checks a password against a policy. Invalid passwords fail with
+	// INVALID_ARGUMENT and a google.rpc.BadRequest detail listing every violation.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// ValidateBatch checks several passwords at once. Each password gets its own
+	// result, so one invalid password does not fail the whole batch.
+	ValidateBatch(context.Context, *ValidateBatchRequest) (*ValidateBatchResponse, error)
+	// Strength estimates how hard a password is to guess.
+	Strength(context.Context, *StrengthRequest) (*StrengthResponse, error)
+	// Generate returns a random password that satisfies a policy.
+	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
+	mustEmbedUnimplementedPasswordValidatorServer()
+}
+
+// UnimplementedPasswordValidatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPasswordValidatorServer struct{}
+
+func (UnimplementedPasswordValidatorServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedPasswordValidatorServer) ValidateBatch(context.Context, *ValidateBatchRequest) (*ValidateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateBatch not implemented")
+}
+func (UnimplementedPasswordValidatorServer) Strength(context.Context, *StrengthRequest) (*StrengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Strength not implemented")
+}
+func (UnimplementedPasswordValidatorServer) Generate(context.Context, *GenerateRequest) (*GenerateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedPasswordValidatorServer) mustEmbedUnimplementedPasswordValidatorServer() {}
+func (UnimplementedPasswordValidatorServer) testEmbeddedByValue()                           {}
+
+// UnsafePasswordValidatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PasswordValidatorServer will
+// result in compilation errors.
+type UnsafePasswordValidatorServer interface {
+	mustEmbedUnimplementedPasswordValidatorServer()
+}
+
+func RegisterPasswordValidatorServer(s grpc.ServiceRegistrar, srv PasswordValidatorServer) {
+	// If the following call pancis, it indicates UnimplementedPasswordValidatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PasswordValidator_ServiceDesc, srv)
+}
+
+func _PasswordValidator_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordValidatorServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasswordValidator_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordValidatorServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PasswordValidator_ValidateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordValidatorServer).ValidateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasswordValidator_ValidateBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordValidatorServer).ValidateBatch(ctx, req.(*ValidateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PasswordValidator_Strength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrengthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordValidatorServer).Strength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasswordValidator_Strength_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordValidatorServer).Strength(ctx, req.(*StrengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PasswordValidator_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordValidatorServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasswordValidator_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordValidatorServer).Generate(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PasswordValidator_ServiceDesc is the grpc.ServiceDesc for PasswordValidator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PasswordValidator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "passwordvalidator.v1.PasswordValidator",
+	HandlerType: (*PasswordValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _PasswordValidator_Validate_Handler,
+		},
+		{
+			MethodName: "ValidateBatch",
+			Handler:    _PasswordValidator_ValidateBatch_Handler,
+		},
+		{
+			MethodName: "Strength",
+			Handler:    _PasswordValidator_Strength_Handler,
+		},
+		{
+			MethodName: "Generate",
+			Handler:    _PasswordValidator_Generate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "password_validator.proto",
+}
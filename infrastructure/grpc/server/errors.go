@@ -0,0 +1,84 @@
+package server
+
+import (
+	"errors"
+	_errors "password-validator/core/errors"
+	"password-validator/core/usecase/output"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const errorDomain = "password-validator"
+
+// toStatus maps domain errors to gRPC status codes the same way handler.HandleErrors
+// maps them to HTTP statuses. Violations, when known, replace the single InvalidField
+// message in the BadRequest detail so clients see every broken rule.
+func toStatus(err error, violations []output.Violation) error {
+	var notFound _errors.NotFoundError
+	var invalidField _errors.InvalidField
+	var rateLimited _errors.RateLimitedError
+	switch {
+	case errors.As(err, &notFound):
+		return withDetails(status.New(codes.NotFound, err.Error()), &errdetails.ResourceInfo{
+			ResourceType: notFound.Entity,
+			ResourceName: notFound.ID,
+			Description:  err.Error(),
+		}, errorInfo(err))
+	case errors.As(err, &invalidField):
+		badRequest := &errdetails.BadRequest{}
+		var reasons []string
+		for _, v := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       invalidField.Field,
+				Description: v.Message,
+			})
+			reasons = append(reasons, v.Code)
+		}
+		if len(badRequest.FieldViolations) == 0 {
+			badRequest.FieldViolations = []*errdetails.BadRequest_FieldViolation{{
+				Field:       invalidField.Field,
+				Description: invalidField.AsIs,
+			}}
+		}
+		info := errorInfo(err)
+		info.Metadata = map[string]string{"field": invalidField.Field}
+		if len(reasons) > 0 {
+			info.Metadata["violations"] = strings.Join(reasons, ",")
+		}
+		return withDetails(status.New(codes.InvalidArgument, err.Error()), badRequest, info)
+	case errors.As(err, &_errors.UnauthorizedError{}):
+		return withDetails(status.New(codes.Unauthenticated, err.Error()), errorInfo(err))
+	case errors.As(err, &_errors.MalformedRequestError{}), errors.As(err, &_errors.UnsupportedMediaTypeError{}):
+		return withDetails(status.New(codes.InvalidArgument, err.Error()), errorInfo(err))
+	case errors.As(err, &_errors.PayloadTooLargeError{}):
+		return withDetails(status.New(codes.ResourceExhausted, err.Error()), errorInfo(err))
+	case errors.As(err, &rateLimited):
+		st := status.New(codes.ResourceExhausted, err.Error())
+		if rateLimited.RetryAfter > 0 {
+			return withDetails(st, errorInfo(err), &errdetails.RetryInfo{RetryDelay: durationpb.New(rateLimited.RetryAfter)})
+		}
+		return withDetails(st, errorInfo(err))
+	case errors.As(err, &_errors.DependencyUnavailableError{}):
+		return withDetails(status.New(codes.Unavailable, err.Error()), errorInfo(err))
+	default:
+		return withDetails(status.New(codes.Internal, err.Error()), errorInfo(err))
+	}
+}
+
+// errorInfo carries the code of err as the reason, in upper case as gRPC reasons are.
+func errorInfo(err error) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: strings.ToUpper(_errors.Code(err)), Domain: errorDomain}
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"errors"
+	_errors "password-validator/core/errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tt := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{name: "not found", err: _errors.NotFoundError{Entity: "Policy", ID: "missing"}, code: codes.NotFound, reason: "POLICY_NOT_FOUND"},
+		{name: "unauthorized", err: _errors.UnauthorizedError{Reason: "unknown API key"}, code: codes.Unauthenticated, reason: "UNAUTHORIZED"},
+		{name: "malformed request", err: _errors.MalformedRequestError{Cause: errors.New("EOF")}, code: codes.InvalidArgument, reason: "MALFORMED_REQUEST"},
+		{name: "payload too large", err: _errors.PayloadTooLargeError{Limit: 8}, code: codes.ResourceExhausted, reason: "PAYLOAD_TOO_LARGE"},
+		{name: "rate limited", err: _errors.RateLimitedError{RetryAfter: time.Second}, code: codes.ResourceExhausted, reason: "RATE_LIMITED"},
+		{name: "dependency unavailable", err: _errors.DependencyUnavailableError{Dependency: "policy store", Cause: errors.New("timeout")}, code: codes.Unavailable, reason: "DEPENDENCY_UNAVAILABLE"},
+		{name: "internal", err: errors.New("boom"), code: codes.Internal, reason: "INTERNAL_ERROR"},
+	}
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			st := status.Convert(toStatus(test.err, nil))
+
+			assert.Equal(t, test.code, st.Code())
+			var reason string
+			for _, detail := range st.Details() {
+				if info, ok := detail.(*errdetails.ErrorInfo); ok {
+					reason = info.GetReason()
+				}
+			}
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
+
+func TestToStatusRetryInfo(t *testing.T) {
+	st := status.Convert(toStatus(_errors.RateLimitedError{RetryAfter: 2 * time.Second}, nil))
+
+	require.Len(t, st.Details(), 2)
+	assert.Equal(t, 2*time.Second, st.Details()[1].(*errdetails.RetryInfo).GetRetryDelay().AsDuration())
+}
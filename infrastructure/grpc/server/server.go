@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/tenant"
+	appConfig "password-validator/infrastructure/config"
+	"password-validator/infrastructure/container"
+	"password-validator/infrastructure/grpc/pb"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+	oteltrace "github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/trace"
+)
+
+// Metadata keys selecting the tenant, the gRPC counterparts of the X-Tenant-ID and
+// X-API-Key headers.
+const (
+	tenantMetadata = "x-tenant-id"
+	apiKeyMetadata = "x-api-key"
+
+	// languageMetadata selects the language of rule messages, like Accept-Language.
+	languageMetadata = "accept-language"
+)
+
+type grpcServer struct {
+	port            int64
+	maxMessageBytes int64
+	tenants         tenant.Registry
+	service         *passwordValidatorService
+}
+
+func Init(c *container.Container) *grpcServer {
+	log := logger.FromContext(context.Background())
+	intPort, err := strconv.ParseInt(appConfig.C.GrpcServerPort, 10, 64)
+	if err != nil {
+		log.Fatal("error parsing gRPC port to int", err)
+	}
+
+	log.Info("gRPC server has been successfully configured.")
+	return &grpcServer{
+		port:            intPort,
+		maxMessageBytes: appConfig.C.MaxBodyBytes,
+		tenants:         c.Tenants,
+		service:         newPasswordValidatorService(c),
+	}
+}
+
+func (s *grpcServer) Start(ctx context.Context, wg *sync.WaitGroup) {
+	log := logger.FromContext(ctx)
+	server, healthServer := s.newServer()
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		log.Error("Error starting gRPC server", err)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := server.Serve(listener); err != nil {
+			logger.FromContext(context.Background()).Error("Error serving gRPC server", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		log.Info("Shutting down gRPC server...")
+		healthServer.Shutdown()
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			server.Stop()
+		}
+		log.Info("gRPC server exiting")
+	}()
+}
+
+func (s *grpcServer) newServer() (*grpc.Server, *health.Server) {
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(unaryInterceptor, s.tenantInterceptor, languageInterceptor)}
+	// The gRPC counterpart of the HTTP body size limit, which zero disables.
+	maxMessageBytes := s.maxMessageBytes
+	if maxMessageBytes <= 0 || maxMessageBytes > math.MaxInt32 {
+		maxMessageBytes = math.MaxInt32
+	}
+	opts = append(opts, grpc.MaxRecvMsgSize(int(maxMessageBytes)))
+	server := grpc.NewServer(opts...)
+	pb.RegisterPasswordValidatorServer(server, s.service)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(pb.PasswordValidator_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+
+	return server, healthServer
+}
+
+// unaryInterceptor traces every call and turns handler panics into Internal errors,
+// like the tracing and recovery middlewares of the Gin router.
+func unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"method": info.FullMethod})
+	newCtx, span := oteltrace.NewSpan(ctx, "password-validator", info.FullMethod)
+	defer span.End()
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic: %v", r)
+			log.Error("Recovered from gRPC handler panic", err)
+		}
+		if err != nil {
+			span.SetStatus(otelcodes.Error, info.FullMethod+" Error")
+			span.RecordError(err)
+			return
+		}
+		span.SetStatus(otelcodes.Ok, info.FullMethod+" execution finished with success")
+	}()
+
+	return handler(newCtx, req)
+}
+
+// tenantInterceptor resolves the tenant of the call from its metadata and puts it in
+// the context, like the tenant middleware of the Gin router. Health checks and
+// reflection are served without a tenant.
+func (s *grpcServer) tenantInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := info.Server.(*passwordValidatorService); !ok {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	id, err := s.tenants.Resolve(first(tenantMetadata), first(apiKeyMetadata))
+	if err != nil {
+		return nil, toStatus(err, nil)
+	}
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("tenant", id))
+	return handler(tenant.NewContext(ctx, id), req)
+}
+
+// languageInterceptor puts the language the accept-language metadata prefers in the
+// context, like the language middleware of the Gin router.
+func languageInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return handler(locale.NewContext(ctx, locale.Negotiate(strings.Join(md.Get(languageMetadata), ","))), req)
+}
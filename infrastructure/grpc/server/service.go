@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"password-validator/core/usecase"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"password-validator/infrastructure/container"
+	"password-validator/infrastructure/grpc/pb"
+)
+
+type passwordValidatorService struct {
+	pb.UnimplementedPasswordValidatorServer
+	validatePasswordUseCase      usecase.ValidatePasswordUseCase
+	validatePasswordBatchUseCase usecase.ValidatePasswordBatchUseCase
+	estimateStrengthUseCase      usecase.EstimateStrengthUseCase
+	generatePasswordUseCase      usecase.GeneratePasswordUseCase
+}
+
+var _ pb.PasswordValidatorServer = (*passwordValidatorService)(nil)
+
+func newPasswordValidatorService(c *container.Container) *passwordValidatorService {
+	return &passwordValidatorService{
+		validatePasswordUseCase:      c.ValidatePasswordUseCase,
+		validatePasswordBatchUseCase: c.ValidatePasswordBatchUseCase,
+		estimateStrengthUseCase:      c.EstimateStrengthUseCase,
+		generatePasswordUseCase:      c.GeneratePasswordUseCase,
+	}
+}
+
+func (s *passwordValidatorService) Validate(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	out, err := s.validatePasswordUseCase.Execute(ctx, input.PasswordInput{
+		Password: req.GetPassword(),
+		Policy:   req.GetPolicy(),
+	})
+	if err != nil {
+		return nil, toStatus(err, out.Violations)
+	}
+	return &pb.ValidateResponse{
+		IsValid:       out.IsValid,
+		Policy:        out.Policy,
+		PolicyVersion: out.PolicyVersion,
+		Violations:    toViolations(out.Violations),
+	}, nil
+}
+
+func (s *passwordValidatorService) ValidateBatch(ctx context.Context, req *pb.ValidateBatchRequest) (*pb.ValidateBatchResponse, error) {
+	i := input.PasswordBatchInput{Passwords: make([]input.PasswordInput, 0, len(req.GetRequests()))}
+	for _, r := range req.GetRequests() {
+		i.Passwords = append(i.Passwords, input.PasswordInput{
+			Password: r.GetPassword(),
+			Policy:   r.GetPolicy(),
+		})
+	}
+
+	out, err := s.validatePasswordBatchUseCase.Execute(ctx, i)
+	if err != nil {
+		return nil, toStatus(err, nil)
+	}
+
+	results := make([]*pb.ValidateBatchResult, 0, len(out.Results))
+	for _, r := range out.Results {
+		results = append(results, &pb.ValidateBatchResult{
+			IsValid:       r.IsValid,
+			Policy:        r.Policy,
+			PolicyVersion: r.PolicyVersion,
+			Violations:    toViolations(r.Violations),
+			Error:         r.Error,
+		})
+	}
+	return &pb.ValidateBatchResponse{Results: results}, nil
+}
+
+func (s *passwordValidatorService) Strength(ctx context.Context, req *pb.StrengthRequest) (*pb.StrengthResponse, error) {
+	out, err := s.estimateStrengthUseCase.Execute(ctx, input.StrengthInput{Password: req.GetPassword()})
+	if err != nil {
+		return nil, toStatus(err, nil)
+	}
+	return &pb.StrengthResponse{
+		Score:   int32(out.Score),
+		Entropy: out.Entropy,
+		Label:   out.Label,
+	}, nil
+}
+
+func (s *passwordValidatorService) Generate(ctx context.Context, req *pb.GenerateRequest) (*pb.GenerateResponse, error) {
+	out, err := s.generatePasswordUseCase.Execute(ctx, input.GenerateInput{
+		Policy: req.GetPolicy(),
+		Length: int(req.GetLength()),
+	})
+	if err != nil {
+		return nil, toStatus(err, nil)
+	}
+	return &pb.GenerateResponse{
+		Password: out.Password,
+		Policy:   out.Policy,
+	}, nil
+}
+
+func toViolations(violations []output.Violation) []*pb.Violation {
+	var result []*pb.Violation
+	for _, v := range violations {
+		result = append(result, &pb.Violation{Code: v.Code, Message: v.Message})
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"net"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/tenant"
+	"password-validator/infrastructure/container"
+	"password-validator/infrastructure/grpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	s := &grpcServer{service: newPasswordValidatorService(container.New(time.Second, tenant.Registry{}, nil, nil))}
+	server, _ := s.newServer()
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestValidate(t *testing.T) {
+	client := pb.NewPasswordValidatorClient(newTestClient(t))
+	tt := []struct {
+		name         string
+		request      *pb.ValidateRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "valid password",
+			request:      &pb.ValidateRequest{Password: "AbTp9!fok"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "invalid password",
+			request:      &pb.ValidateRequest{Password: "AbTp9!foA"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "policy not found",
+			request:      &pb.ValidateRequest{Password: "AbTp9!fok", Policy: "missing"},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := client.Validate(context.Background(), test.request)
+
+			assert.Equal(t, test.expectedCode, status.Code(err))
+			if err == nil {
+				assert.True(t, res.GetIsValid())
+				assert.Equal(t, policy.DefaultName, res.GetPolicy())
+				assert.Equal(t, policy.Default().Version(), res.GetPolicyVersion())
+			}
+		})
+	}
+}
+
+func TestValidateErrorDetails(t *testing.T) {
+	client := pb.NewPasswordValidatorClient(newTestClient(t))
+
+	_, err := client.Validate(context.Background(), &pb.ValidateRequest{Password: "aa"})
+
+	st := status.Convert(err)
+	require.Len(t, st.Details(), 2)
+	badRequest := st.Details()[0].(*errdetails.BadRequest)
+	assert.Len(t, badRequest.GetFieldViolations(), 5)
+	assert.Equal(t, "password", badRequest.GetFieldViolations()[0].GetField())
+	errorInfo := st.Details()[1].(*errdetails.ErrorInfo)
+	assert.Equal(t, "min_length,unique_characters,digit,uppercase,special_character", errorInfo.GetMetadata()["violations"])
+}
+
+func TestValidateLanguage(t *testing.T) {
+	client := pb.NewPasswordValidatorClient(newTestClient(t))
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "pt-BR")
+
+	_, err := client.Validate(ctx, &pb.ValidateRequest{Password: "aa"})
+
+	badRequest := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, "Deve ter pelo menos 9 caracteres (sem contar espaços)", badRequest.GetFieldViolations()[0].GetDescription())
+}
+
+func TestValidateBatch(t *testing.T) {
+	client := pb.NewPasswordValidatorClient(newTestClient(t))
+
+	res, err := client.ValidateBatch(context.Background(), &pb.ValidateBatchRequest{Requests: []*pb.ValidateRequest{
+		{Password: "AbTp9!fok"},
+		{Password: "AbTp9!foA"},
+		{Password: "AbTp9!fok", Policy: "missing"},
+	}})
+
+	require.NoError(t, err)
+	require.Len(t, res.GetResults(), 3)
+	assert.True(t, res.GetResults()[0].GetIsValid())
+	assert.False(t, res.GetResults()[1].GetIsValid())
+	assert.Equal(t, "unique_characters", res.GetResults()[1].GetViolations()[0].GetCode())
+	assert.Empty(t, res.GetResults()[1].GetError())
+	assert.Equal(t, "Policy not found with ID 'missing'", res.GetResults()[2].GetError())
+
+	tooMany := make([]*pb.ValidateRequest, 1001)
+	_, err = client.ValidateBatch(context.Background(), &pb.ValidateBatchRequest{Requests: tooMany})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStrengthAndGenerate(t *testing.T) {
+	client := pb.NewPasswordValidatorClient(newTestClient(t))
+
+	strength, err := client.Strength(context.Background(), &pb.StrengthRequest{Password: "AbTp9!fok"})
+	require.NoError(t, err)
+	assert.Equal(t, "fair", strength.GetLabel())
+
+	generated, err := client.Generate(context.Background(), &pb.GenerateRequest{Length: 12})
+	require.NoError(t, err)
+	assert.Len(t, generated.GetPassword(), 12)
+
+	_, err = client.Generate(context.Background(), &pb.GenerateRequest{Length: 4})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.True(t, strings.Contains(status.Convert(err).Message(), "length"))
+}
+
+func TestHealth(t *testing.T) {
+	client := healthpb.NewHealthClient(newTestClient(t))
+
+	res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: pb.PasswordValidator_ServiceDesc.ServiceName})
+
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+}
+
+func TestTenantInterceptor(t *testing.T) {
+	s := &grpcServer{tenants: tenant.Registry{Tenants: []tenant.Tenant{
+		{ID: "cards", APIKeys: []string{"2dd1ca33e0c22c628da491fae3723ec6134bfef22486aaa50c6a48a286ac98e6"}},
+	}}}
+	info := &grpc.UnaryServerInfo{Server: &passwordValidatorService{}, FullMethod: "/password_validator.PasswordValidator/Validate"}
+	tt := []struct {
+		name           string
+		metadata       metadata.MD
+		expectedTenant string
+		expectedCode   codes.Code
+	}{
+		{
+			name:           "no metadata selects the default tenant",
+			expectedTenant: tenant.Default,
+		},
+		{
+			name:           "tenant by API key",
+			metadata:       metadata.Pairs("x-api-key", "cards-key"),
+			expectedTenant: "cards",
+		},
+		{
+			name:         "tenant requiring a key",
+			metadata:     metadata.Pairs("x-tenant-id", "cards"),
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), test.metadata)
+			var got string
+			_, err := s.tenantInterceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+				got = tenant.FromContext(ctx)
+				return nil, nil
+			})
+
+			assert.Equal(t, test.expectedCode, status.Code(err))
+			assert.Equal(t, test.expectedTenant, got)
+		})
+	}
+}
@@ -15,11 +15,534 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/password/feedback": {
+            "get": {
+                "description": "Upgrades to a WebSocket. Each text message {\"seq\": 1, \"password\": \"...\", \"policy\": \"...\"} is answered with the satisfied and violated rules and the strength of the draft. Idle sessions are closed after 30 seconds and messages over 4 KiB close the session.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Real-time password feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols"
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/generate": {
+            "post": {
+                "description": "Generates a random password that satisfies the given policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Generate password",
+                "parameters": [
+                    {
+                        "description": "Password generation request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/input.GenerateInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Generated password",
+                        "schema": {
+                            "$ref": "#/definitions/output.GenerateOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid length",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/policies": {
+            "get": {
+                "description": "Describes every policy the validator enforces, with its rules and the message reported when each rule is broken",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policy"
+                ],
+                "summary": "List password policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Policies",
+                        "schema": {
+                            "$ref": "#/definitions/output.PolicyListOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/policies/{name}": {
+            "get": {
+                "description": "Describes a single policy, with its rules and the message reported when each rule is broken",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policy"
+                ],
+                "summary": "Describe a password policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Policy",
+                        "schema": {
+                            "$ref": "#/definitions/output.PolicyOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/policies/{name}/compliance": {
+            "get": {
+                "description": "Assesses a policy, with the tenant blocklist, against NIST SP 800-63B, PCI DSS 4.0, OWASP ASVS V2 and ISO/IEC 27001, listing the requirements met, the gaps and those to verify outside the policy. Responds with Markdown when format is markdown or the Accept header asks for text/markdown",
+                "produces": [
+                    "application/json",
+                    "text/markdown"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "Report the compliance of a password policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "markdown"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Compliance report",
+                        "schema": {
+                            "$ref": "#/definitions/output.ComplianceOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/policies/{name}/versions": {
+            "get": {
+                "description": "Lists the current version of a policy followed by its retired versions from the policy file history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policy"
+                ],
+                "summary": "List the versions of a password policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Policy versions",
+                        "schema": {
+                            "$ref": "#/definitions/output.PolicyVersionsOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/policies/{name}/versions/{version}": {
+            "get": {
+                "description": "Describes a policy as it was at the given version, including retired versions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Policy"
+                ],
+                "summary": "Describe a password policy version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Policy version",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Policy",
+                        "schema": {
+                            "$ref": "#/definitions/output.PolicyOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Policy version not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/reports/outdated": {
+            "get": {
+                "description": "Counts the stored passwords accepted under a policy version that is no longer current, grouped by policy and version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "Report passwords accepted under outdated policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Outdated passwords",
+                        "schema": {
+                            "$ref": "#/definitions/output.OutdatedPasswordsOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/strength": {
+            "post": {
+                "description": "Estimates the entropy of a password and scores it from 0 (very weak) to 4 (very strong)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Estimate password strength",
+                "parameters": [
+                    {
+                        "description": "Password strength request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/input.StrengthInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Strength estimate",
+                        "schema": {
+                            "$ref": "#/definitions/output.StrengthOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
         "/password/validate": {
             "post": {
                 "description": "Validates a password according to security rules",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
@@ -37,19 +560,201 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/input.PasswordInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation result",
+                        "schema": {
+                            "$ref": "#/definitions/output.PasswordOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request, or unknown or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported content type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/validate/batch": {
+            "post": {
+                "description": "Validates up to 1000 passwords in a single request, returning one result per password in the same order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Validate a batch of passwords",
+                "parameters": [
+                    {
+                        "description": "Password batch validation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/input.PasswordBatchInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation results",
+                        "schema": {
+                            "$ref": "#/definitions/output.PasswordBatchOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unknown tenant or API key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/validate/stream": {
+            "post": {
+                "description": "Validates one password per NDJSON line, streaming one result line per input line",
+                "consumes": [
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Validate passwords from an NDJSON stream",
+                "parameters": [
+                    {
+                        "description": "One password validation request per line",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/input.PasswordInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant, when not selected by the API key",
+                        "name": "X-Tenant-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant API key",
+                        "name": "X-API-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the messages: pt-BR, en (default) or es",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Validation result",
+                        "description": "One validation result per line",
                         "schema": {
                             "$ref": "#/definitions/output.PasswordOutput"
                         }
                     },
-                    "422": {
-                        "description": "Validation error",
+                    "401": {
+                        "description": "Unknown tenant or API key",
                         "schema": {
-                            "$ref": "#/definitions/response.Error"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported content type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -57,29 +762,459 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "input.GenerateInput": {
+            "type": "object",
+            "properties": {
+                "length": {
+                    "type": "integer"
+                },
+                "policy": {
+                    "type": "string"
+                }
+            }
+        },
+        "input.PasswordBatchInput": {
+            "type": "object",
+            "properties": {
+                "passwords": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/input.PasswordInput"
+                    }
+                }
+            }
+        },
         "input.PasswordInput": {
             "type": "object",
             "properties": {
                 "password": {
                     "type": "string"
+                },
+                "policy": {
+                    "type": "string"
+                }
+            }
+        },
+        "input.StrengthInput": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.ComplianceFinding": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "requirement": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.ComplianceOutput": {
+            "type": "object",
+            "properties": {
+                "blocklist": {
+                    "type": "boolean"
+                },
+                "policy": {
+                    "type": "string"
+                },
+                "policyVersion": {
+                    "type": "string"
+                },
+                "standards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.ComplianceStandard"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/output.ComplianceSummary"
+                }
+            }
+        },
+        "output.ComplianceStandard": {
+            "type": "object",
+            "properties": {
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.ComplianceFinding"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.ComplianceSummary": {
+            "type": "object",
+            "properties": {
+                "gaps": {
+                    "type": "integer"
+                },
+                "manual": {
+                    "type": "integer"
+                },
+                "met": {
+                    "type": "integer"
+                }
+            }
+        },
+        "output.Evaluated": {
+            "type": "object",
+            "properties": {
+                "length": {
+                    "type": "integer"
+                },
+                "whitespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.GenerateOutput": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "policy": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.OutdatedPasswordGroup": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "currentVersion": {
+                    "type": "string"
+                },
+                "policy": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.OutdatedPasswordsOutput": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.OutdatedPasswordGroup"
+                    }
+                },
+                "outdated": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "output.PasswordBatchOutput": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.PasswordBatchResult"
+                    }
+                }
+            }
+        },
+        "output.PasswordBatchResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "evaluated": {
+                    "$ref": "#/definitions/output.Evaluated"
+                },
+                "isValid": {
+                    "type": "boolean"
+                },
+                "policy": {
+                    "type": "string"
+                },
+                "policyVersion": {
+                    "type": "string"
+                },
+                "satisfied": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.Violation"
+                    }
                 }
             }
         },
         "output.PasswordOutput": {
             "type": "object",
             "properties": {
+                "evaluated": {
+                    "$ref": "#/definitions/output.Evaluated"
+                },
                 "isValid": {
                     "type": "boolean"
+                },
+                "policy": {
+                    "type": "string"
+                },
+                "policyVersion": {
+                    "type": "string"
+                },
+                "satisfied": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.Violation"
+                    }
                 }
             }
         },
-        "response.Error": {
+        "output.PolicyListOutput": {
             "type": "object",
             "properties": {
-                "error": {
+                "default": {
+                    "type": "string"
+                },
+                "policies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.PolicyOutput"
+                    }
+                }
+            }
+        },
+        "output.PolicyOutput": {
+            "type": "object",
+            "properties": {
+                "allowRepeated": {
+                    "type": "boolean"
+                },
+                "anySpecial": {
+                    "type": "boolean"
+                },
+                "asciiClasses": {
+                    "type": "boolean"
+                },
+                "blocklist": {
+                    "type": "boolean"
+                },
+                "caseInsensitiveRepetition": {
+                    "type": "boolean"
+                },
+                "default": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "maxConsecutive": {
+                    "type": "integer"
+                },
+                "maxLength": {
+                    "type": "integer"
+                },
+                "maxOccurrences": {
+                    "type": "integer"
+                },
+                "minClasses": {
+                    "type": "integer"
+                },
+                "minDigits": {
+                    "type": "integer"
+                },
+                "minLength": {
+                    "type": "integer"
+                },
+                "minLower": {
+                    "type": "integer"
+                },
+                "minSpecial": {
+                    "type": "integer"
+                },
+                "minUnique": {
+                    "type": "integer"
+                },
+                "minUpper": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rejectCommon": {
+                    "type": "boolean"
+                },
+                "requireDigit": {
+                    "type": "boolean"
+                },
+                "requireLower": {
+                    "type": "boolean"
+                },
+                "requireSpecial": {
+                    "type": "boolean"
+                },
+                "requireUpper": {
+                    "type": "boolean"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.PolicyRule"
+                    }
+                },
+                "specialChars": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                },
+                "whitespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "output.PolicyRule": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "output.PolicyVersionsOutput": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/output.PolicyOutput"
+                    }
+                }
+            }
+        },
+        "output.StrengthOutput": {
+            "type": "object",
+            "properties": {
+                "commonRank": {
+                    "type": "integer"
+                },
+                "entropy": {
+                    "type": "number"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "output.Violation": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "response.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProblemError"
+                    }
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "password": {},
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "traceId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ProblemError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "message": {
                     "type": "string"
                 },
-                "password": {}
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
             }
         }
     }
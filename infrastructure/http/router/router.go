@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"password-validator/adapter/controller"
-	"password-validator/adapter/presenter"
-	"password-validator/adapter/repository"
-	"password-validator/core/usecase"
+	"password-validator/adapter/handler"
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/tenant"
+	_errors "password-validator/core/errors"
 	"password-validator/infrastructure/config"
+	"password-validator/infrastructure/container"
 	"sync"
 	"time"
 
@@ -20,6 +22,16 @@ import (
 	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/otel/gintrace"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tenantHeader = "X-Tenant-ID"
+	apiKeyHeader = "X-API-Key"
+
+	// DefaultMaxBodyBytes bounds request bodies read whole, room for a full batch.
+	DefaultMaxBodyBytes = config.DefaultMaxBodyBytes
 )
 
 type (
@@ -30,16 +42,30 @@ type (
 	}
 
 	ginEngine struct {
-		router                     *gin.Engine
-		port                       int64
-		ctxTimeout                 time.Duration
-		validatePasswordController controller.ValidatePasswordController
+		router                           *gin.Engine
+		port                             int64
+		maxBodyBytes                     int64
+		legacyErrors                     bool
+		tenants                          tenant.Registry
+		validatePasswordController       controller.ValidatePasswordController
+		validatePasswordBatchController  controller.ValidatePasswordBatchController
+		validatePasswordStreamController controller.ValidatePasswordStreamController
+		passwordFeedbackController       controller.PasswordFeedbackController
+		estimateStrengthController       controller.EstimateStrengthController
+		generatePasswordController       controller.GeneratePasswordController
+		listPoliciesController           controller.ListPoliciesController
+		getPolicyController              controller.GetPolicyController
+		listPolicyVersionsController     controller.ListPolicyVersionsController
+		getPolicyVersionController       controller.GetPolicyVersionController
+		outdatedPasswordsController      controller.OutdatedPasswordsController
+		complianceReportController       controller.ComplianceReportController
 	}
 )
 
 func NewGinServer() *ginEngine {
 	return &ginEngine{
-		router: gin.New(),
+		router:       gin.New(),
+		maxBodyBytes: DefaultMaxBodyBytes,
 	}
 }
 
@@ -48,19 +74,41 @@ func (engine *ginEngine) WithPort(p int64) *ginEngine {
 	return engine
 }
 
-func (engine *ginEngine) WithDuration(duration time.Duration) *ginEngine {
-	engine.ctxTimeout = duration
+func (engine *ginEngine) WithMaxBodyBytes(n int64) *ginEngine {
+	engine.maxBodyBytes = n
 	return engine
 }
 
-func (engine *ginEngine) WithControllers() *ginEngine {
-	passwordRepository := repository.NewPasswordRepository()
-	validatePasswordPresenter := presenter.NewValidatePasswordPresenter()
-	validatePasswordUseCase := usecase.NewValidatePasswordUseCase(engine.ctxTimeout, passwordRepository, validatePasswordPresenter)
-	engine.validatePasswordController = controller.NewValidatePasswordController(validatePasswordUseCase)
+// WithLegacyErrors answers errors with the {"error": ..., "password": ...} body of
+// earlier releases instead of problem details, for clients not migrated yet.
+func (engine *ginEngine) WithLegacyErrors(legacy bool) *ginEngine {
+	engine.legacyErrors = legacy
 	return engine
 }
 
+func (engine *ginEngine) WithControllers(c *container.Container) *ginEngine {
+	engine.tenants = c.Tenants
+	engine.validatePasswordController = controller.NewValidatePasswordController(c.ValidatePasswordUseCase)
+	engine.validatePasswordBatchController = controller.NewValidatePasswordBatchController(c.ValidatePasswordBatchUseCase)
+	engine.validatePasswordStreamController = controller.NewValidatePasswordStreamController(c.EvaluatePasswordUseCase)
+	engine.passwordFeedbackController = controller.NewPasswordFeedbackController(c.EvaluatePasswordUseCase, c.EstimateStrengthUseCase)
+	engine.estimateStrengthController = controller.NewEstimateStrengthController(c.EstimateStrengthUseCase)
+	engine.generatePasswordController = controller.NewGeneratePasswordController(c.GeneratePasswordUseCase)
+	engine.listPoliciesController = controller.NewListPoliciesController(c.ListPoliciesUseCase)
+	engine.getPolicyController = controller.NewGetPolicyController(c.GetPolicyUseCase)
+	engine.listPolicyVersionsController = controller.NewListPolicyVersionsController(c.ListPolicyVersionsUseCase)
+	engine.getPolicyVersionController = controller.NewGetPolicyVersionController(c.GetPolicyVersionUseCase)
+	engine.outdatedPasswordsController = controller.NewOutdatedPasswordsController(c.OutdatedPasswordsUseCase)
+	engine.complianceReportController = controller.NewComplianceReportController(c.ComplianceReportUseCase)
+	return engine
+}
+
+// Handler returns the router with every route registered, without listening on a port.
+func (engine ginEngine) Handler() http.Handler {
+	engine.setAppHandlers(engine.router)
+	return engine.router
+}
+
 func (engine ginEngine) Listen(ctx context.Context, wg *sync.WaitGroup) {
 	gin.Recovery()
 
@@ -113,25 +161,335 @@ func (engine ginEngine) setAppHandlers(router *gin.Engine) {
 	router.Use(gintrace.Middleware(config.C.AppName, gintrace.SkipUselessRoutesTraceOption()))
 	router.Use(ginmetric.Middleware(config.C.AppName, ginmetric.WithShouldRecordFunc(ginmetric.SkipUselessMetric)))
 	router.Use(logger.Middleware())
+	if engine.legacyErrors {
+		router.Use(legacyErrorsMiddleware())
+	}
 
 	router.GET("/health", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"status": "UP"}) })
-	router.POST("/password/validate", engine.handleValidatePassword())
+
+	passwords := router.Group("/password", engine.tenantMiddleware(), engine.languageMiddleware())
+	// The stream endpoint reads its body a line at a time, with its own line limit.
+	limited := passwords.Group("", engine.bodyLimitMiddleware())
+	limited.POST("/validate", engine.handleValidatePassword())
+	limited.POST("/validate/batch", engine.handleValidatePasswordBatch())
+	passwords.POST("/validate/stream", engine.handleValidatePasswordStream())
+	limited.POST("/strength", engine.handleEstimateStrength())
+	limited.POST("/generate", engine.handleGeneratePassword())
+	passwords.GET("/feedback", engine.handlePasswordFeedback())
+	passwords.GET("/policies", engine.handleListPolicies())
+	passwords.GET("/policies/:name", engine.handleGetPolicy())
+	passwords.GET("/policies/:name/versions", engine.handleListPolicyVersions())
+	passwords.GET("/policies/:name/versions/:version", engine.handleGetPolicyVersion())
+	passwords.GET("/policies/:name/compliance", engine.handleComplianceReport())
+	passwords.GET("/reports/outdated", engine.handleOutdatedPasswords())
+
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 }
 
+func legacyErrorsMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request = ctx.Request.WithContext(handler.WithLegacyErrors(ctx.Request.Context()))
+		ctx.Next()
+	}
+}
+
+// tenantMiddleware resolves the tenant of the request from the X-Tenant-ID and
+// X-API-Key headers and puts it in the request context, where the repositories read
+// it. Requests without either header are served for the default tenant.
+func (engine ginEngine) tenantMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := engine.tenants.Resolve(ctx.GetHeader(tenantHeader), ctx.GetHeader(apiKeyHeader))
+		if err != nil {
+			handler.HandleErrors(ctx.Writer, ctx.Request, err, nil)
+			ctx.Abort()
+			return
+		}
+		trace.SpanFromContext(ctx.Request.Context()).SetAttributes(attribute.String("tenant", id))
+		ctx.Request = ctx.Request.WithContext(tenant.NewContext(ctx.Request.Context(), id))
+		ctx.Next()
+	}
+}
+
+// languageMiddleware puts the language the Accept-Language header prefers in the
+// request context, where the presenters read it to write rule messages.
+func (engine ginEngine) languageMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		language := locale.Negotiate(ctx.GetHeader("Accept-Language"))
+		ctx.Header("Content-Language", language)
+		ctx.Header("Vary", "Accept-Language")
+		ctx.Request = ctx.Request.WithContext(locale.NewContext(ctx.Request.Context(), language))
+		ctx.Next()
+	}
+}
+
+// bodyLimitMiddleware rejects bodies larger than maxBodyBytes with 413: up front when
+// Content-Length announces it, and otherwise once reading goes past the limit. A zero
+// limit lets every body through.
+func (engine ginEngine) bodyLimitMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if engine.maxBodyBytes <= 0 {
+			ctx.Next()
+			return
+		}
+		if ctx.Request.ContentLength > engine.maxBodyBytes {
+			handler.HandleErrors(ctx.Writer, ctx.Request, _errors.PayloadTooLargeError{Limit: engine.maxBodyBytes}, nil)
+			ctx.Abort()
+			return
+		}
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, engine.maxBodyBytes)
+		ctx.Next()
+	}
+}
+
 // Validate Password godoc
 //
 //	@Summary		Validate password
 //	@Description	Validates a password according to security rules
 //	@Tags			Password
-//	@Accept			json
+//	@Accept			json,x-www-form-urlencoded
 //	@Produce		json
 //	@Param			request	body		input.PasswordInput		true	"Password validation request"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
 //	@Success		200		{object}	output.PasswordOutput	"Validation result"
-//	@Failure		422		{object}	response.Error			"Validation error"
+//	@Failure		400		{object}	response.Problem			"Malformed request, or unknown or missing fields"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		422		{object}	response.Problem			"Validation error"
+//	@Failure		413		{object}	response.Problem			"Request body too large"
+//	@Failure		415		{object}	response.Problem			"Unsupported content type"
 //	@Router			/password/validate [post]
 func (engine ginEngine) handleValidatePassword() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		engine.validatePasswordController.Execute(ctx.Writer, ctx.Request)
 	}
 }
+
+// Validate Password Batch godoc
+//
+//	@Summary		Validate a batch of passwords
+//	@Description	Validates up to 1000 passwords in a single request, returning one result per password in the same order
+//	@Tags			Password
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		input.PasswordBatchInput	true	"Password batch validation request"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200		{object}	output.PasswordBatchOutput	"Validation results"
+//	@Failure		400		{object}	response.Problem			"Malformed request"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		422		{object}	response.Problem				"Validation error"
+//	@Failure		413		{object}	response.Problem			"Request body too large"
+//	@Router			/password/validate/batch [post]
+func (engine ginEngine) handleValidatePasswordBatch() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.validatePasswordBatchController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Validate Password Stream godoc
+//
+//	@Summary		Validate passwords from an NDJSON stream
+//	@Description	Validates one password per NDJSON line, streaming one result line per input line
+//	@Tags			Password
+//	@Accept			application/x-ndjson
+//	@Produce		application/x-ndjson
+//	@Param			request	body		input.PasswordInput		true	"One password validation request per line"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200		{object}	output.PasswordOutput	"One validation result per line"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		415		{object}	response.Problem			"Unsupported content type"
+//	@Router			/password/validate/stream [post]
+func (engine ginEngine) handleValidatePasswordStream() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.validatePasswordStreamController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Password Feedback godoc
+//
+//	@Summary		Real-time password feedback
+//	@Description	Upgrades to a WebSocket. Each text message {"seq": 1, "password": "...", "policy": "..."} is answered with the satisfied and violated rules and the strength of the draft. Idle sessions are closed after 30 seconds and messages over 4 KiB close the session.
+//	@Tags			Password
+//	@Produce		json
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		101	"Switching protocols"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Router			/password/feedback [get]
+func (engine ginEngine) handlePasswordFeedback() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.passwordFeedbackController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Estimate Strength godoc
+//
+//	@Summary		Estimate password strength
+//	@Description	Estimates the entropy of a password and scores it from 0 (very weak) to 4 (very strong)
+//	@Tags			Password
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		input.StrengthInput		true	"Password strength request"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200		{object}	output.StrengthOutput	"Strength estimate"
+//	@Failure		400		{object}	response.Problem			"Malformed request"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		413		{object}	response.Problem			"Request body too large"
+//	@Router			/password/strength [post]
+func (engine ginEngine) handleEstimateStrength() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.estimateStrengthController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Generate Password godoc
+//
+//	@Summary		Generate password
+//	@Description	Generates a random password that satisfies the given policy
+//	@Tags			Password
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		input.GenerateInput		false	"Password generation request"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Success		200		{object}	output.GenerateOutput	"Generated password"
+//	@Failure		400		{object}	response.Problem			"Malformed request"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		404		{object}	response.Problem			"Policy not found"
+//	@Failure		422		{object}	response.Problem			"Invalid length"
+//	@Failure		413		{object}	response.Problem			"Request body too large"
+//	@Router			/password/generate [post]
+func (engine ginEngine) handleGeneratePassword() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.generatePasswordController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// List Policies godoc
+//
+//	@Summary		List password policies
+//	@Description	Describes every policy the validator enforces, with its rules and the message reported when each rule is broken
+//	@Tags			Policy
+//	@Produce		json
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200	{object}	output.PolicyListOutput	"Policies"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Router			/password/policies [get]
+func (engine ginEngine) handleListPolicies() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.listPoliciesController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Get Policy godoc
+//
+//	@Summary		Describe a password policy
+//	@Description	Describes a single policy, with its rules and the message reported when each rule is broken
+//	@Tags			Policy
+//	@Produce		json
+//	@Param			name	path		string					true	"Policy name"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200		{object}	output.PolicyOutput		"Policy"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		404		{object}	response.Problem			"Policy not found"
+//	@Router			/password/policies/{name} [get]
+func (engine ginEngine) handleGetPolicy() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.SetPathValue("name", ctx.Param("name"))
+		engine.getPolicyController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// List Policy Versions godoc
+//
+//	@Summary		List the versions of a password policy
+//	@Description	Lists the current version of a policy followed by its retired versions from the policy file history
+//	@Tags			Policy
+//	@Produce		json
+//	@Param			name	path		string						true	"Policy name"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200		{object}	output.PolicyVersionsOutput	"Policy versions"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		404		{object}	response.Problem				"Policy not found"
+//	@Router			/password/policies/{name}/versions [get]
+func (engine ginEngine) handleListPolicyVersions() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.SetPathValue("name", ctx.Param("name"))
+		engine.listPolicyVersionsController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Get Policy Version godoc
+//
+//	@Summary		Describe a password policy version
+//	@Description	Describes a policy as it was at the given version, including retired versions
+//	@Tags			Policy
+//	@Produce		json
+//	@Param			name	path		string				true	"Policy name"
+//	@Param			version	path		string				true	"Policy version"
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Param			Accept-Language	header		string			false	"Language of the messages: pt-BR, en (default) or es"
+//	@Success		200		{object}	output.PolicyOutput	"Policy"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		404		{object}	response.Problem		"Policy version not found"
+//	@Router			/password/policies/{name}/versions/{version} [get]
+func (engine ginEngine) handleGetPolicyVersion() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.SetPathValue("name", ctx.Param("name"))
+		ctx.Request.SetPathValue("version", ctx.Param("version"))
+		engine.getPolicyVersionController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Outdated Passwords godoc
+//
+//	@Summary		Report passwords accepted under outdated policies
+//	@Description	Counts the stored passwords accepted under a policy version that is no longer current, grouped by policy and version
+//	@Tags			Report
+//	@Produce		json
+//	@Param			X-Tenant-ID	header		string			false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string			false	"Tenant API key"
+//	@Success		200	{object}	output.OutdatedPasswordsOutput	"Outdated passwords"
+//	@Failure		401		{object}	response.Problem			"Unknown tenant or API key"
+//	@Router			/password/reports/outdated [get]
+func (engine ginEngine) handleOutdatedPasswords() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		engine.outdatedPasswordsController.Execute(ctx.Writer, ctx.Request)
+	}
+}
+
+// Compliance Report godoc
+//
+//	@Summary		Report the compliance of a password policy
+//	@Description	Assesses a policy, with the tenant blocklist, against NIST SP 800-63B, PCI DSS 4.0, OWASP ASVS V2 and ISO/IEC 27001, listing the requirements met, the gaps and those to verify outside the policy. Responds with Markdown when format is markdown or the Accept header asks for text/markdown
+//	@Tags			Report
+//	@Produce		json
+//	@Produce		text/markdown
+//	@Param			name		path		string					true	"Policy name"
+//	@Param			format		query		string					false	"Response format"	Enums(json, markdown)
+//	@Param			X-Tenant-ID	header		string					false	"Tenant, when not selected by the API key"
+//	@Param			X-API-Key	header		string					false	"Tenant API key"
+//	@Success		200			{object}	output.ComplianceOutput	"Compliance report"
+//	@Failure		401			{object}	response.Problem			"Unknown tenant or API key"
+//	@Failure		404			{object}	response.Problem			"Policy not found"
+//	@Router			/password/policies/{name}/compliance [get]
+func (engine ginEngine) handleComplianceReport() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.SetPathValue("name", ctx.Param("name"))
+		engine.complianceReportController.Execute(ctx.Writer, ctx.Request)
+	}
+}
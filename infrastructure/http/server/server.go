@@ -3,11 +3,11 @@ package server
 import (
 	"context"
 	appConfig "password-validator/infrastructure/config"
+	"password-validator/infrastructure/container"
 	"password-validator/infrastructure/http/router"
 
 	"strconv"
 	"sync"
-	"time"
 
 	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
 )
@@ -20,21 +20,18 @@ func NewConfig() *config {
 	return &config{}
 }
 
-func (config *config) WithWebServer() *config {
+func (config *config) WithWebServer(c *container.Container) *config {
 	log := logger.FromContext(context.Background())
 	intPort, err := strconv.ParseInt(appConfig.C.HttpServerPort, 10, 64)
 	if err != nil {
 		log.Fatal("error parsing port to int", err)
 	}
-	duration, err := time.ParseDuration(appConfig.C.ServerTimeout + "s")
-	if err != nil {
-		log.Fatal("error parsing duration to time duration", err)
-	}
 	server := router.
 		NewGinServer().
 		WithPort(intPort).
-		WithDuration(duration).
-		WithControllers()
+		WithMaxBodyBytes(appConfig.C.MaxBodyBytes).
+		WithLegacyErrors(appConfig.C.LegacyErrors).
+		WithControllers(c)
 
 	log.Info("Router server has been successfully configured.")
 
@@ -42,9 +39,9 @@ func (config *config) WithWebServer() *config {
 	return config
 }
 
-func Init() *config {
+func Init(c *container.Container) *config {
 	return NewConfig().
-		WithWebServer()
+		WithWebServer(c)
 }
 
 func (config *config) Start(ctx context.Context, wg *sync.WaitGroup) {
@@ -0,0 +1,190 @@
+package reload
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/signal"
+	"password-validator/adapter/repository"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/policy"
+	appConfig "password-validator/infrastructure/config"
+	"password-validator/infrastructure/container"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/itau-corp/itau-jw1-dep-golibs-gotel/logger"
+)
+
+// Editors and Kubernetes ConfigMap updates touch a file several times in a row, so
+// reloads wait for the events to settle.
+const debounce = 200 * time.Millisecond
+
+type (
+	reloader struct {
+		targets  []*target
+		debounce time.Duration
+		mu       sync.Mutex
+	}
+
+	// target is a policy or blocklist file of a tenant. apply parses the file and
+	// swaps it into the repository serving it, returning the fields to log.
+	target struct {
+		tenant  string
+		path    string
+		apply   func([]byte) (logger.Field, error)
+		current []byte
+	}
+)
+
+func Init(c *container.Container) *reloader {
+	r := &reloader{debounce: debounce}
+	for id, path := range container.PolicyFiles(c.Tenants) {
+		r.addPolicies(id, path, c.PolicyRepositories[id])
+	}
+	for id, path := range container.BlocklistFiles(c.Tenants) {
+		r.addBlocklist(id, path, appConfig.C.BlocklistSubstringLength, appConfig.C.BlocklistConfusables, c.BlocklistRepositories[id])
+	}
+	return r
+}
+
+func (r *reloader) addPolicies(tenant, path string, policies *repository.PolicyRepository) {
+	r.add(tenant, path, func(data []byte) (logger.Field, error) {
+		document, err := policy.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+		policies.Replace(document)
+
+		versions := make(map[string]string, len(document.Policies))
+		for _, p := range document.Policies {
+			versions[p.Name] = p.Version()
+		}
+		return logger.Field{"policies": versions}, nil
+	})
+}
+
+func (r *reloader) addBlocklist(tenant, path string, substringLength int, confusables bool, blocklists *repository.BlocklistRepository) {
+	r.add(tenant, path, func(data []byte) (logger.Field, error) {
+		terms, err := blocklist.Parse(data, substringLength)
+		if err != nil {
+			return nil, err
+		}
+		if confusables {
+			terms = terms.WithConfusables()
+		}
+		blocklists.Replace(terms)
+		return logger.Field{"terms": terms.Len()}, nil
+	})
+}
+
+func (r *reloader) add(tenant, path string, apply func([]byte) (logger.Field, error)) {
+	current, _ := os.ReadFile(path)
+	r.targets = append(r.targets, &target{
+		tenant:  tenant,
+		path:    path,
+		apply:   apply,
+		current: current,
+	})
+}
+
+// Start reloads the policy and blocklist files when they change on disk or when the process
+// receives SIGHUP, until ctx is done. Directories are watched rather than files, so
+// replacing a file, as editors and ConfigMap updates do, is also noticed.
+func (r *reloader) Start(ctx context.Context, wg *sync.WaitGroup) {
+	log := logger.FromContext(ctx)
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	var watcher *fsnotify.Watcher
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	if len(r.targets) > 0 {
+		w, err := fsnotify.NewWatcher()
+		if err != nil {
+			log.Error("Error creating file watcher", err)
+		} else {
+			watcher, events, errs = w, w.Events, w.Errors
+			for _, t := range r.targets {
+				if err := w.Add(filepath.Dir(t.path)); err != nil {
+					log.WithFields(logger.Field{"file": t.path}).Error("Error watching file", err)
+				}
+			}
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer signal.Stop(hangup)
+		if watcher != nil {
+			defer watcher.Close()
+		}
+
+		var settled <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hangup:
+				log.Info("SIGHUP received, reloading policies and blocklists")
+				r.reload(ctx)
+			case <-events:
+				settled = time.After(r.debounce)
+			case <-settled:
+				settled = nil
+				r.reload(ctx)
+			case err := <-errs:
+				log.Error("Error watching files", err)
+			}
+		}
+	}()
+}
+
+// reload parses every file and swaps in the ones that changed. A file that fails to
+// parse is rejected as a whole and its tenant keeps the current policies or
+// blocklist.
+func (r *reloader) reload(ctx context.Context) error {
+	if len(r.targets) == 0 {
+		logger.FromContext(ctx).Info("No policy or blocklist file configured, nothing to reload")
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var failed error
+	for _, t := range r.targets {
+		if err := t.reload(ctx); err != nil {
+			failed = err
+		}
+	}
+	return failed
+}
+
+func (t *target) reload(ctx context.Context) error {
+	log := logger.FromContext(ctx).WithFields(logger.Field{"tenant": t.tenant, "file": t.path})
+
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		log.Error("Error reading file, keeping the current configuration", err)
+		return err
+	}
+	if bytes.Equal(data, t.current) {
+		return nil
+	}
+	fields, err := t.apply(data)
+	if err != nil {
+		log.Error("Invalid file, keeping the current configuration", err)
+		return err
+	}
+
+	t.current = data
+	log.WithFields(fields).Info("File reloaded")
+	return nil
+}
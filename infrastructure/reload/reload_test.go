@@ -0,0 +1,130 @@
+package reload
+
+import (
+	"context"
+	"os"
+	"password-validator/adapter/repository"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/policy"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReload(t *testing.T) {
+	tt := []struct {
+		name        string
+		content     string
+		minLength   int
+		expectedErr bool
+	}{
+		{
+			name:      "valid file replaces the policies",
+			content:   `{"policies":[{"name":"strict","minLength":16}]}`,
+			minLength: 16,
+		},
+		{
+			name:        "invalid file keeps the current policies",
+			content:     `{"policies":[{"name":"strict","minLength":-1}]}`,
+			minLength:   12,
+			expectedErr: true,
+		},
+		{
+			name:        "malformed file keeps the current policies",
+			content:     `{"policies":`,
+			minLength:   12,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policies.json")
+			assert.NoError(t, os.WriteFile(path, []byte(`{"policies":[{"name":"strict","minLength":12}]}`), 0o600))
+			document, err := policy.LoadFile(path)
+			assert.NoError(t, err)
+			policies := repository.NewPolicyRepository(document)
+			r := &reloader{}
+			r.addPolicies("default", path, policies)
+
+			assert.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+			err = r.reload(context.Background())
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+			p, err := policies.FindByName(context.Background(), "strict")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.minLength, p.MinLength)
+		})
+	}
+}
+
+func TestReloadTenants(t *testing.T) {
+	dir := t.TempDir()
+	cardsPath := filepath.Join(dir, "cards.json")
+	loansPath := filepath.Join(dir, "loans.json")
+	assert.NoError(t, os.WriteFile(cardsPath, []byte(`{"policies":[{"name":"strict","minLength":12}]}`), 0o600))
+	assert.NoError(t, os.WriteFile(loansPath, []byte(`{"policies":[{"name":"strict","minLength":12}]}`), 0o600))
+	cards := repository.NewPolicyRepository(policy.Document{Default: "strict", Policies: []policy.Policy{{Name: "strict", MinLength: 12}}})
+	loans := repository.NewPolicyRepository(policy.Document{Default: "strict", Policies: []policy.Policy{{Name: "strict", MinLength: 12}}})
+	r := &reloader{}
+	r.addPolicies("cards", cardsPath, cards)
+	r.addPolicies("loans", loansPath, loans)
+
+	assert.NoError(t, os.WriteFile(cardsPath, []byte(`{"policies":[{"name":"strict","minLength":16}]}`), 0o600))
+	assert.NoError(t, os.WriteFile(loansPath, []byte(`{"policies":`), 0o600))
+	err := r.reload(context.Background())
+
+	assert.Error(t, err)
+	p, _ := cards.FindByName(context.Background(), "strict")
+	assert.Equal(t, 16, p.MinLength)
+	p, _ = loans.FindByName(context.Background(), "strict")
+	assert.Equal(t, 12, p.MinLength)
+}
+
+func TestReloadOnFileChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policies.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"policies":[{"name":"strict","minLength":12}]}`), 0o600))
+	document, err := policy.LoadFile(path)
+	assert.NoError(t, err)
+	policies := repository.NewPolicyRepository(document)
+	r := &reloader{debounce: 10 * time.Millisecond}
+	r.addPolicies("default", path, policies)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	r.Start(ctx, &wg)
+	defer wg.Wait()
+	defer cancel()
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"policies":[{"name":"strict","minLength":20}]}`), 0o600))
+
+	assert.Eventually(t, func() bool {
+		p, err := policies.FindByName(context.Background(), "strict")
+		return err == nil && p.MinLength == 20
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestReloadBlocklist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("acme\n"), 0o600))
+	terms, err := blocklist.LoadFile(path, blocklist.DefaultSubstringLength)
+	assert.NoError(t, err)
+	blocklists := repository.NewBlocklistRepository(terms)
+	r := &reloader{}
+	r.addBlocklist("default", path, blocklist.DefaultSubstringLength, false, blocklists)
+
+	assert.NoError(t, os.WriteFile(path, []byte("acme\nglobex\n"), 0o600))
+	assert.NoError(t, r.reload(context.Background()))
+
+	b, _ := blocklists.Find(context.Background())
+	assert.True(t, b.Match("Gl0bex!2024"))
+
+	assert.NoError(t, os.WriteFile(path, []byte("acme\n--\n"), 0o600))
+	assert.Error(t, r.reload(context.Background()))
+
+	b, _ = blocklists.Find(context.Background())
+	assert.True(t, b.Match("Gl0bex!2024"))
+}
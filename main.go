@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"os/signal"
 	"password-validator/infrastructure/config"
+	"password-validator/infrastructure/container"
+	grpcServer "password-validator/infrastructure/grpc/server"
 	httpServer "password-validator/infrastructure/http/server"
+	"password-validator/infrastructure/reload"
 	"sync"
 	"syscall"
 
@@ -28,7 +31,10 @@ func main() {
 		gotel.WithLoggingConfig(config.C.AppName, config.C.AppVersion, config.C.LoggingLevel, config.C.Environment),
 	)
 
-	httpServer.Init().Start(ctx, &wg)
+	c := container.Init()
+	httpServer.Init(c).Start(ctx, &wg)
+	grpcServer.Init(c).Start(ctx, &wg)
+	reload.Init(c).Start(ctx, &wg)
 
 	wg.Wait()
 }
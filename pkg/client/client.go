@@ -0,0 +1,351 @@
+// Package client is a typed Go client for the password-validator HTTP API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"password-validator/core/usecase/input"
+	"password-validator/core/usecase/output"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	DefaultMaxRetries = 3
+	DefaultBackoff    = 100 * time.Millisecond
+	maxBackoff        = 5 * time.Second
+)
+
+type (
+	PasswordInput           = input.PasswordInput
+	PasswordBatchInput      = input.PasswordBatchInput
+	StrengthInput           = input.StrengthInput
+	GenerateInput           = input.GenerateInput
+	PasswordOutput          = output.PasswordOutput
+	PasswordBatchOutput     = output.PasswordBatchOutput
+	PasswordBatchResult     = output.PasswordBatchResult
+	Violation               = output.Violation
+	StrengthOutput          = output.StrengthOutput
+	GenerateOutput          = output.GenerateOutput
+	PolicyOutput            = output.PolicyOutput
+	PolicyRule              = output.PolicyRule
+	PolicyListOutput        = output.PolicyListOutput
+	PolicyVersionsOutput    = output.PolicyVersionsOutput
+	OutdatedPasswordsOutput = output.OutdatedPasswordsOutput
+	OutdatedPasswordGroup   = output.OutdatedPasswordGroup
+
+	Client struct {
+		baseURL    string
+		httpClient *http.Client
+		maxRetries int
+		backoff    time.Duration
+		retryAll   bool
+		tenant     string
+		apiKey     string
+	}
+
+	Option func(*Client)
+
+	// errorBody reads both error bodies of the server: problem details, with the
+	// error in detail, and the legacy one.
+	errorBody struct {
+		Error    string          `json:"error"`
+		Detail   string          `json:"detail"`
+		Code     string          `json:"code"`
+		Errors   []problemError  `json:"errors"`
+		Password *PasswordOutput `json:"password,omitempty"`
+	}
+
+	problemError struct {
+		Code    string         `json:"code"`
+		Field   string         `json:"field"`
+		Message string         `json:"message"`
+		Params  map[string]any `json:"params"`
+	}
+)
+
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q", baseURL)
+	}
+
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		maxRetries: DefaultMaxRetries,
+		backoff:    DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithMaxRetries sets how many times a request is retried after a network error,
+// a 429 or a 5xx. Zero disables retries.
+//
+// Validate and ValidateBatch store the passwords they accept, so they are only
+// retried when the server refused the request with a 429, or a 503 carrying
+// Retry-After. See WithRetryNonIdempotent.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		c.maxRetries = n
+	}
+}
+
+// WithRetryNonIdempotent also retries Validate and ValidateBatch after network errors
+// and any 5xx. A request that failed after the server stored the password is then
+// stored again.
+func WithRetryNonIdempotent(enabled bool) Option {
+	return func(c *Client) {
+		c.retryAll = enabled
+	}
+}
+
+// WithBackoff sets the base delay between retries. It doubles on every attempt, with
+// jitter, up to 5 seconds. A Retry-After header sent by the server takes precedence.
+func WithBackoff(d time.Duration) Option {
+	return func(c *Client) {
+		c.backoff = d
+	}
+}
+
+// WithTenant sends every request for the given tenant. Tenants protected by API
+// keys are selected with WithAPIKey instead.
+func WithTenant(id string) Option {
+	return func(c *Client) {
+		c.tenant = id
+	}
+}
+
+// WithAPIKey authenticates every request with a tenant API key, which also selects
+// the tenant.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// Validate validates a single password. When the password breaks its policy the
+// returned output still carries the violations, along with an *InvalidFieldError.
+func (c *Client) Validate(ctx context.Context, i PasswordInput) (PasswordOutput, error) {
+	var out PasswordOutput
+	err := c.do(ctx, http.MethodPost, "/password/validate", false, i, &out)
+	var invalid *InvalidFieldError
+	if errors.As(err, &invalid) && invalid.Password != nil {
+		out = *invalid.Password
+	}
+	return out, err
+}
+
+func (c *Client) ValidateBatch(ctx context.Context, i PasswordBatchInput) (PasswordBatchOutput, error) {
+	var out PasswordBatchOutput
+	err := c.do(ctx, http.MethodPost, "/password/validate/batch", false, i, &out)
+	return out, err
+}
+
+func (c *Client) Strength(ctx context.Context, i StrengthInput) (StrengthOutput, error) {
+	var out StrengthOutput
+	err := c.do(ctx, http.MethodPost, "/password/strength", true, i, &out)
+	return out, err
+}
+
+func (c *Client) Generate(ctx context.Context, i GenerateInput) (GenerateOutput, error) {
+	var out GenerateOutput
+	err := c.do(ctx, http.MethodPost, "/password/generate", true, i, &out)
+	return out, err
+}
+
+// Policies describes every policy the server enforces.
+func (c *Client) Policies(ctx context.Context) (PolicyListOutput, error) {
+	var out PolicyListOutput
+	err := c.do(ctx, http.MethodGet, "/password/policies", true, nil, &out)
+	return out, err
+}
+
+// Policy describes a single policy, returning a *NotFoundError for unknown names.
+func (c *Client) Policy(ctx context.Context, name string) (PolicyOutput, error) {
+	var out PolicyOutput
+	err := c.do(ctx, http.MethodGet, "/password/policies/"+url.PathEscape(name), true, nil, &out)
+	return out, err
+}
+
+// PolicyVersions lists the current and retired versions of a policy.
+func (c *Client) PolicyVersions(ctx context.Context, name string) (PolicyVersionsOutput, error) {
+	var out PolicyVersionsOutput
+	err := c.do(ctx, http.MethodGet, "/password/policies/"+url.PathEscape(name)+"/versions", true, nil, &out)
+	return out, err
+}
+
+// PolicyVersion describes a policy as it was at the given version.
+func (c *Client) PolicyVersion(ctx context.Context, name, version string) (PolicyOutput, error) {
+	var out PolicyOutput
+	err := c.do(ctx, http.MethodGet, "/password/policies/"+url.PathEscape(name)+"/versions/"+url.PathEscape(version), true, nil, &out)
+	return out, err
+}
+
+// OutdatedPasswords counts the stored passwords accepted under a policy version that
+// is no longer current.
+func (c *Client) OutdatedPasswords(ctx context.Context) (OutdatedPasswordsOutput, error) {
+	var out OutdatedPasswordsOutput
+	err := c.do(ctx, http.MethodGet, "/password/reports/outdated", true, nil, &out)
+	return out, err
+}
+
+// do sends a request, retrying it on transient failures. Requests that are not
+// idempotent are only retried when the server did not process them, unless the
+// client was built WithRetryNonIdempotent.
+func (c *Client) do(ctx context.Context, method, path string, idempotent bool, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+
+	idempotent = idempotent || c.retryAll
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, method, path, body)
+		var retryAfter time.Duration
+		var hasRetryAfter bool
+		if err == nil {
+			retryAfter, hasRetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			if !retryable(resp.StatusCode, hasRetryAfter, idempotent) {
+				defer resp.Body.Close()
+				return decode(resp, out)
+			}
+		} else if !idempotent {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if attempt >= c.maxRetries {
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			return decode(resp, out)
+		}
+
+		wait := c.delay(attempt)
+		if err == nil {
+			if hasRetryAfter {
+				wait = retryAfter
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.tenant != "" {
+		req.Header.Set("X-Tenant-ID", c.tenant)
+	}
+	if c.apiKey != "" {
+		req.Header.Set("X-API-Key", c.apiKey)
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return c.httpClient.Do(req)
+}
+
+// delay returns the exponential backoff for the given attempt with full jitter.
+func (c *Client) delay(attempt int) time.Duration {
+	if c.backoff <= 0 {
+		return 0
+	}
+	d := c.backoff << attempt
+	if d <= 0 || d > maxBackoff {
+		d = maxBackoff
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryable reports whether a response is worth retrying. A 429, or a 503 with
+// Retry-After, means the server refused the request without processing it, so only
+// those are retried for requests that are not idempotent.
+func retryable(status int, hasRetryAfter, idempotent bool) bool {
+	switch {
+	case status == http.StatusTooManyRequests:
+		return true
+	case status == http.StatusServiceUnavailable && hasRetryAfter:
+		return true
+	default:
+		return idempotent && status >= http.StatusInternalServerError
+	}
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+func decode(resp *http.Response, out interface{}) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return json.Unmarshal(data, out)
+	}
+
+	var body errorBody
+	if err := json.Unmarshal(data, &body); err == nil && body.Error == "" {
+		body.Error = body.Detail
+	}
+	if body.Error == "" {
+		return &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+	return parseError(resp.StatusCode, body)
+}
@@ -0,0 +1,443 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"password-validator/core/domain/blocklist"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/tenant"
+	constants "password-validator/core/utils"
+	"password-validator/infrastructure/container"
+	"password-validator/infrastructure/http/router"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestServer(t *testing.T, wrap func(http.Handler) http.Handler) *Client {
+	t.Helper()
+	c := container.New(time.Second, tenant.Registry{}, nil, nil)
+	var handler http.Handler = router.NewGinServer().WithControllers(c).Handler()
+	if wrap != nil {
+		handler = wrap(handler)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := New(server.URL, WithHTTPClient(server.Client()), WithBackoff(0))
+	assert.NoError(t, err)
+	return client
+}
+
+func TestClientValidate(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	out, err := client.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok"})
+	assert.NoError(t, err)
+	assert.True(t, out.IsValid)
+	assert.Equal(t, "default", out.Policy)
+
+	out, err = client.Validate(context.Background(), PasswordInput{Password: "AbTp9!foA"})
+	var invalid *InvalidFieldError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "password", invalid.Field)
+	assert.False(t, out.IsValid)
+	assert.Equal(t, "unique_characters", out.Violations[0].Code)
+
+	_, err = client.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok", Policy: "missing"})
+	var notFound *NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+	assert.Equal(t, &NotFoundError{Entity: "Policy", ID: "missing", Message: "Policy not found with ID 'missing'"}, notFound)
+}
+
+func TestClientLegacyErrors(t *testing.T) {
+	c := container.New(time.Second, tenant.Registry{}, nil, nil)
+	server := httptest.NewServer(router.NewGinServer().WithLegacyErrors(true).WithControllers(c).Handler())
+	t.Cleanup(server.Close)
+	client, err := New(server.URL, WithHTTPClient(server.Client()))
+	assert.NoError(t, err)
+
+	out, err := client.Validate(context.Background(), PasswordInput{Password: "AbTp9!foA"})
+
+	var invalid *InvalidFieldError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "Must not contain repeated characters (excluding spaces)", invalid.AsIs)
+	assert.Equal(t, "unique_characters", out.Violations[0].Code)
+
+	_, err = client.Policy(context.Background(), "missing")
+
+	var notFound *NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+	assert.Empty(t, notFound.Entity)
+	assert.Equal(t, "Policy not found with ID 'missing'", notFound.Error())
+}
+
+func TestClientBodyTooLarge(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	_, err := client.Validate(context.Background(), PasswordInput{Password: strings.Repeat("a", router.DefaultMaxBodyBytes)})
+
+	var apiErr *APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, apiErr.StatusCode)
+	assert.Equal(t, "payload_too_large", apiErr.Code)
+}
+
+func TestClientValidateBatch(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	out, err := client.ValidateBatch(context.Background(), PasswordBatchInput{Passwords: []PasswordInput{
+		{Password: "AbTp9!fok"},
+		{Password: "AbTp9!foA"},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, out.Results, 2)
+	assert.True(t, out.Results[0].IsValid)
+	assert.False(t, out.Results[1].IsValid)
+}
+
+func TestClientStrength(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	out, err := client.Strength(context.Background(), StrengthInput{Password: "AbTp9!fok"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, out.Label)
+	assert.Greater(t, out.Entropy, 0.0)
+}
+
+func TestClientGenerate(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	out, err := client.Generate(context.Background(), GenerateInput{Length: 20})
+	assert.NoError(t, err)
+	assert.Len(t, out.Password, 20)
+	assert.Equal(t, "default", out.Policy)
+
+	_, err = client.Generate(context.Background(), GenerateInput{Length: 1000})
+	var invalid *InvalidFieldError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "length", invalid.Field)
+}
+
+func TestClientRetries(t *testing.T) {
+	tt := []struct {
+		name             string
+		failures         int32
+		status           int
+		retryAfter       bool
+		idempotent       bool
+		opts             []Option
+		expectedAttempts int32
+		expectedErr      bool
+	}{
+		{
+			name:             "recovers after transient failures",
+			failures:         2,
+			status:           http.StatusBadGateway,
+			idempotent:       true,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "retries rate limited requests",
+			failures:         1,
+			status:           http.StatusTooManyRequests,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "retries unavailable requests with retry after",
+			failures:         2,
+			status:           http.StatusServiceUnavailable,
+			retryAfter:       true,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "does not retry a failed validation",
+			failures:         1,
+			status:           http.StatusBadGateway,
+			expectedAttempts: 1,
+			expectedErr:      true,
+		},
+		{
+			name:             "does not retry a validation unavailable without retry after",
+			failures:         1,
+			status:           http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+			expectedErr:      true,
+		},
+		{
+			name:             "retries a failed validation when opted in",
+			failures:         2,
+			status:           http.StatusBadGateway,
+			opts:             []Option{WithRetryNonIdempotent(true)},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "gives up after max retries",
+			failures:         10,
+			status:           http.StatusBadGateway,
+			idempotent:       true,
+			expectedAttempts: DefaultMaxRetries + 1,
+			expectedErr:      true,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			client := newTestServer(t, func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if attempts.Add(1) <= test.failures {
+						if test.retryAfter || test.status == http.StatusTooManyRequests {
+							w.Header().Set("Retry-After", "0")
+						}
+						http.Error(w, "unavailable", test.status)
+						return
+					}
+					next.ServeHTTP(w, r)
+				})
+			})
+			for _, opt := range test.opts {
+				opt(client)
+			}
+
+			var err error
+			if test.idempotent {
+				_, err = client.Strength(context.Background(), StrengthInput{Password: "AbTp9!fok"})
+			} else {
+				_, err = client.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok"})
+			}
+
+			assert.Equal(t, test.expectedAttempts, attempts.Load())
+			if test.expectedErr {
+				var apiErr *APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, test.status, apiErr.StatusCode)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestClientDoesNotRetryValidationAfterNetworkError(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestServer(t, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			conn, _, err := w.(http.Hijacker).Hijack()
+			assert.NoError(t, err)
+			conn.Close()
+		})
+	})
+
+	_, err := client.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), attempts.Load())
+
+	_, err = client.Policies(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(2+DefaultMaxRetries), attempts.Load())
+}
+
+func TestClientPropagatesTraceContext(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	var traceparent string
+	client := newTestServer(t, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent = r.Header.Get("traceparent")
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	_, err := client.Strength(ctx, StrengthInput{Password: "AbTp9!fok"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceparent)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("localhost")
+	assert.Error(t, err)
+
+	client, err := New("http://localhost:8080/", WithMaxRetries(0))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080", client.baseURL)
+	assert.Equal(t, 0, client.maxRetries)
+}
+
+func TestClientPolicies(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	list, err := client.Policies(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, policy.DefaultName, list.Default)
+	assert.Len(t, list.Policies, 1)
+	assert.True(t, list.Policies[0].Default)
+
+	p, err := client.Policy(context.Background(), policy.DefaultName)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, p.MinLength)
+	assert.Equal(t, PolicyRule{Code: "min_length", Message: "Must have at least 9 characters (excluding spaces)", Params: map[string]any{"min": float64(9), "whitespace": "strip"}}, p.Rules[0])
+
+	_, err = client.Policy(context.Background(), "missing")
+	var notFound *NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+	assert.Equal(t, &NotFoundError{Entity: "Policy", ID: "missing", Message: "Policy not found with ID 'missing'"}, notFound)
+
+	versions, err := client.PolicyVersions(context.Background(), policy.DefaultName)
+	assert.NoError(t, err)
+	assert.Equal(t, policy.Default().Version(), versions.Current)
+
+	p, err = client.PolicyVersion(context.Background(), policy.DefaultName, versions.Current)
+	assert.NoError(t, err)
+	assert.Equal(t, versions.Current, p.Version)
+}
+
+func TestClientOutdatedPasswords(t *testing.T) {
+	client := newTestServer(t, nil)
+
+	out, err := client.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok"})
+	assert.NoError(t, err)
+	assert.Equal(t, policy.Default().Version(), out.PolicyVersion)
+
+	report, err := client.OutdatedPasswords(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, OutdatedPasswordsOutput{Total: 1, Groups: []OutdatedPasswordGroup{}}, report)
+}
+
+func TestClientTenants(t *testing.T) {
+	strict := policy.Default()
+	strict.MinLength = 12
+	c := container.New(time.Second, tenant.Registry{Tenants: []tenant.Tenant{
+		{ID: "cards", APIKeys: []string{"2dd1ca33e0c22c628da491fae3723ec6134bfef22486aaa50c6a48a286ac98e6"}},
+		{ID: "loans"},
+	}}, map[string]policy.Document{
+		"cards": {Default: policy.DefaultName, Policies: []policy.Policy{strict}},
+	}, map[string]blocklist.Blocklist{
+		"loans": blocklist.New([]string{"acme"}, blocklist.DefaultSubstringLength),
+	})
+	server := httptest.NewServer(router.NewGinServer().WithControllers(c).Handler())
+	t.Cleanup(server.Close)
+	newClient := func(opts ...Option) *Client {
+		client, err := New(server.URL, append(opts, WithHTTPClient(server.Client()), WithBackoff(0))...)
+		assert.NoError(t, err)
+		return client
+	}
+	cards := newClient(WithAPIKey("cards-key"))
+	loans := newClient(WithTenant("loans"))
+
+	_, err := cards.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok"})
+	var invalid *InvalidFieldError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "min_length", invalid.Password.Violations[0].Code)
+
+	out, err := loans.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok"})
+	assert.NoError(t, err)
+	assert.True(t, out.IsValid)
+
+	_, err = loans.Validate(context.Background(), PasswordInput{Password: "@cme1957!Xy"})
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "blocklisted_term", invalid.Password.Violations[0].Code)
+	out, err = newClient().Validate(context.Background(), PasswordInput{Password: "@cme1957!Xy"})
+	assert.NoError(t, err)
+	assert.True(t, out.IsValid)
+
+	report, err := loans.OutdatedPasswords(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Total)
+	report, err = newClient().OutdatedPasswords(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Total)
+
+	_, err = newClient(WithTenant("cards")).Policies(context.Background())
+	var apiErr *APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func TestClientLocalizedErrors(t *testing.T) {
+	tt := []struct {
+		name             string
+		language         string
+		legacy           bool
+		expectedMessage  string
+		expectedNotFound *NotFoundError
+	}{
+		{
+			name:             "pt-BR problem details",
+			language:         "pt-BR",
+			expectedMessage:  "Não deve conter caracteres repetidos (sem contar espaços)",
+			expectedNotFound: &NotFoundError{Entity: "Policy", ID: "missing", Message: "Policy not found with ID 'missing'"},
+		},
+		{
+			name:             "es problem details",
+			language:         "es",
+			expectedMessage:  "No debe contener caracteres repetidos (sin contar espacios)",
+			expectedNotFound: &NotFoundError{Entity: "Policy", ID: "missing", Message: "Policy not found with ID 'missing'"},
+		},
+		{
+			name:             "es legacy body",
+			language:         "es",
+			legacy:           true,
+			expectedMessage:  "No debe contener caracteres repetidos (sin contar espacios)",
+			expectedNotFound: &NotFoundError{Message: "Policy not found with ID 'missing'"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			c := container.New(time.Second, tenant.Registry{}, nil, nil)
+			handler := router.NewGinServer().WithLegacyErrors(test.legacy).WithControllers(c).Handler()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Header.Set("Accept-Language", test.language)
+				handler.ServeHTTP(w, r)
+			}))
+			t.Cleanup(server.Close)
+			client, err := New(server.URL, WithHTTPClient(server.Client()))
+			assert.NoError(t, err)
+
+			_, err = client.Validate(context.Background(), PasswordInput{Password: "AbTp9!foA"})
+			var invalid *InvalidFieldError
+			assert.ErrorAs(t, err, &invalid)
+			assert.Equal(t, "password", invalid.Field)
+			assert.Equal(t, test.expectedMessage, invalid.AsIs)
+
+			_, err = client.Validate(context.Background(), PasswordInput{Password: "AbTp9!fok", Policy: "missing"})
+			var notFound *NotFoundError
+			assert.ErrorAs(t, err, &notFound)
+			assert.Equal(t, test.expectedNotFound, notFound)
+		})
+	}
+}
+
+func TestClientValidateFullBatch(t *testing.T) {
+	client := newTestServer(t, nil)
+	passwords := make([]PasswordInput, constants.MAX_BATCH_SIZE)
+	for i := range passwords {
+		passwords[i] = PasswordInput{Password: strings.Repeat("a", password.MaxInputBytes), Policy: policy.DefaultName}
+	}
+
+	out, err := client.ValidateBatch(context.Background(), PasswordBatchInput{Passwords: passwords})
+
+	assert.NoError(t, err)
+	assert.Len(t, out.Results, constants.MAX_BATCH_SIZE)
+}
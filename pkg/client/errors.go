@@ -0,0 +1,91 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	codeInvalidField   = "invalid_field"
+	codeNotFoundSuffix = "_not_found"
+)
+
+type (
+	// InvalidFieldError mirrors the server's InvalidField error, returned with a 422.
+	// Password is set when the server also sent the validation result.
+	InvalidFieldError struct {
+		Field    string
+		AsIs     string
+		Password *PasswordOutput
+	}
+
+	// NotFoundError mirrors the server's NotFoundError, returned with a 404. Legacy
+	// bodies name neither the entity nor the ID, so only Message is set for them.
+	NotFoundError struct {
+		Entity  string
+		ID      string
+		Message string
+	}
+
+	// APIError is returned for any other unsuccessful response. Code is the stable
+	// error code of the server, such as malformed_request or internal_error, when
+	// the response has one.
+	APIError struct {
+		StatusCode int
+		Code       string
+		Message    string
+	}
+)
+
+func (e *InvalidFieldError) Error() string {
+	return fmt.Sprintf("Field [%s] is invalid. %s.", e.Field, e.AsIs)
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Entity == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s not found with ID '%s'", e.Entity, e.ID)
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("password-validator: status %d: %s", e.StatusCode, e.Message)
+}
+
+// parseError picks the error type from the status and the stable error code of the
+// response, never from its message, which the server localizes. Legacy bodies carry
+// no code, so only the status is checked for them.
+func parseError(status int, body errorBody) error {
+	switch {
+	case status == http.StatusUnprocessableEntity && (body.Code == "" || body.Code == codeInvalidField):
+		return invalidFieldError(body)
+	case status == http.StatusNotFound && (body.Code == "" || strings.HasSuffix(body.Code, codeNotFoundSuffix)):
+		return notFoundError(body)
+	}
+	return &APIError{StatusCode: status, Code: body.Code, Message: body.Error}
+}
+
+// notFoundError reads the entity and its ID from the params of the first entry of
+// errors, which legacy bodies do not have.
+func notFoundError(body errorBody) *NotFoundError {
+	err := &NotFoundError{Message: body.Error}
+	if len(body.Errors) > 0 {
+		err.Entity, _ = body.Errors[0].Params["entity"].(string)
+		err.ID, _ = body.Errors[0].Params["id"].(string)
+	}
+	return err
+}
+
+// invalidFieldError reads the field and its message from the first entry of errors,
+// or from the validation result of a legacy body.
+func invalidFieldError(body errorBody) *InvalidFieldError {
+	err := &InvalidFieldError{AsIs: body.Error, Password: body.Password}
+	switch {
+	case len(body.Errors) > 0:
+		err.Field, err.AsIs = body.Errors[0].Field, body.Errors[0].Message
+	case body.Password != nil && len(body.Password.Violations) > 0:
+		err.Field, err.AsIs = "password", body.Password.Violations[0].Message
+	}
+	return err
+}
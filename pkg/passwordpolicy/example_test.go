@@ -0,0 +1,26 @@
+package passwordpolicy_test
+
+import (
+	"fmt"
+	"password-validator/pkg/passwordpolicy"
+)
+
+func ExampleValidate() {
+	result := passwordpolicy.Validate("AbTp9!foA", passwordpolicy.Default())
+
+	fmt.Println(result.Valid)
+	for _, v := range result.Violations {
+		fmt.Printf("%s: %s\n", v.Code, v.Message)
+	}
+	// Output:
+	// false
+	// unique_characters: Must not contain repeated characters (excluding spaces)
+}
+
+func ExampleEstimateStrength() {
+	s := passwordpolicy.EstimateStrength("AbTp9!fok")
+
+	fmt.Println(s.Score, s.Label)
+	// Output:
+	// 2 fair
+}
@@ -0,0 +1,144 @@
+// Package passwordpolicy validates passwords in-process, with the same rules the
+// password-validator service applies, without a network hop.
+//
+// It only depends on the standard library, golang.org/x/text (for Unicode
+// normalization and language matching) and the service's domain packages, so
+// importing it does not pull in Gin, viper or the telemetry stack.
+package passwordpolicy
+
+import (
+	"password-validator/core/domain/locale"
+	"password-validator/core/domain/password"
+	"password-validator/core/domain/policy"
+	"password-validator/core/domain/strength"
+)
+
+// Rule codes reported in Result.Violations and Result.Satisfied.
+const (
+	CodeMinLength        = password.CodeMinLength
+	CodeMaxLength        = password.CodeMaxLength
+	CodeUniqueCharacters = password.CodeUniqueCharacters
+	CodeDigit            = password.CodeDigit
+	CodeLowercase        = password.CodeLowercase
+	CodeUppercase        = password.CodeUppercase
+	CodeSpecialCharacter = password.CodeSpecialCharacter
+	CodeCharacterClasses = password.CodeCharacterClasses
+	CodeWhitespace       = password.CodeWhitespace
+	CodeCommonPassword   = password.CodeCommonPassword
+	CodeBlocklistedTerm  = password.CodeBlocklistedTerm
+	CodeInvalidCharacter = password.CodeInvalidCharacter
+
+	CodeConsecutiveCharacters = password.CodeConsecutiveCharacters
+	CodeCharacterOccurrences  = password.CodeCharacterOccurrences
+	CodeMinUniqueCharacters   = password.CodeMinUniqueCharacters
+)
+
+// Strength labels, from score 0 to 4.
+const (
+	VeryWeak   = strength.VeryWeak
+	Weak       = strength.Weak
+	Fair       = strength.Fair
+	Strong     = strength.Strong
+	VeryStrong = strength.VeryStrong
+)
+
+// Languages of the violation messages. Violations always carry English messages;
+// Message writes them in another one.
+const (
+	English      = locale.English
+	PortugueseBR = locale.PortugueseBR
+	Spanish      = locale.Spanish
+)
+
+const (
+	DefaultName            = policy.DefaultName
+	DefaultGeneratedLength = password.DefaultGeneratedLength
+	MaxGeneratedLength     = password.MaxGeneratedLength
+	MaxInputBytes          = password.MaxInputBytes
+)
+
+type (
+	// Policy is a set of password rules. Build one by hand, start from Default or
+	// load it from a policy file with Parse or LoadFile. Omitted fields take the
+	// same defaults in every case; New also rejects invalid policies.
+	Policy = policy.Policy
+
+	// Document is the policy file format: a list of named policies and the name of
+	// the one used when none is given.
+	Document = policy.Document
+
+	Violation = password.Violation
+
+	// Params are the values a violation message interpolates, by name.
+	Params = password.Params
+
+	Strength = strength.Strength
+
+	// Result is the outcome of Validate. Every broken rule is listed in Violations,
+	// not just the first one. Evaluated is the form of the password the rules
+	// checked, after normalization and the policy whitespace mode.
+	Result struct {
+		Valid         bool
+		Policy        string
+		PolicyVersion string
+		Violations    []Violation
+		Satisfied     []string
+		Evaluated     string
+	}
+)
+
+// Default returns the policy the service applies when none is configured.
+func Default() Policy {
+	return policy.Default()
+}
+
+// New checks a policy built by hand the way Parse checks a policy file, and returns
+// it with the defaults of its omitted fields.
+func New(p Policy) (Policy, error) {
+	return policy.Validate(p)
+}
+
+// Parse reads a policy document, rejecting unknown fields and invalid policies.
+func Parse(data []byte) (Document, error) {
+	return policy.Parse(data)
+}
+
+func LoadFile(path string) (Document, error) {
+	return policy.LoadFile(path)
+}
+
+// Validate checks pw against p and reports every rule it satisfies and breaks.
+func Validate(pw string, p Policy) Result {
+	p = p.WithDefaults()
+	validated, _ := password.New(password.WithPassword(pw), password.WithPolicy(p))
+	return Result{
+		Valid:         validated.IsValid(),
+		Policy:        p.Name,
+		PolicyVersion: p.Version(),
+		Violations:    validated.Violations(),
+		Satisfied:     validated.Satisfied(),
+		Evaluated:     validated.Evaluated(),
+	}
+}
+
+// Message returns the message of a violation in language, from its code and
+// params. Unsupported languages get the English message.
+func Message(language string, v Violation) string {
+	return password.Message(language, v.Code, v.Params)
+}
+
+// EstimateStrength scores pw from 0 (very weak) to 4 (very strong) regardless of
+// any policy.
+func EstimateStrength(pw string) Strength {
+	return strength.Estimate(pw)
+}
+
+// Generate returns a random password that satisfies p. A zero length picks the
+// larger of DefaultGeneratedLength and the policy minimum, within the policy maximum.
+func Generate(p Policy, length int) (string, error) {
+	generated, err := password.Generate(p.WithDefaults(), length)
+	if err != nil {
+		return "", err
+	}
+	return generated.Password(), nil
+}
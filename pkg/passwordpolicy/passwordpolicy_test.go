@@ -0,0 +1,104 @@
+package passwordpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tt := []struct {
+		name               string
+		password           string
+		policy             Policy
+		expectedValid      bool
+		expectedViolations []string
+	}{
+		{
+			name:          "valid with default policy",
+			password:      "AbTp9!fok",
+			policy:        Default(),
+			expectedValid: true,
+		},
+		{
+			name:               "reports every broken rule",
+			password:           "aa",
+			policy:             Default(),
+			expectedViolations: []string{CodeMinLength, CodeUniqueCharacters, CodeDigit, CodeUppercase, CodeSpecialCharacter},
+		},
+		{
+			name:          "custom policy",
+			password:      "correct horse battery staple",
+			policy:        Policy{Name: "passphrase", MinLength: 20, RequireLower: true, AllowRepeated: true},
+			expectedValid: true,
+		},
+		{
+			name:          "special characters default when none are listed",
+			password:      "AbTp9!fok",
+			policy:        Policy{Name: "special", MinLength: 9, RequireSpecial: true},
+			expectedValid: true,
+		},
+		{
+			name:               "exported codes",
+			password:           "password\x00",
+			policy:             Policy{Name: "common", MinLength: 8, AllowRepeated: true, RejectCommon: true},
+			expectedViolations: []string{CodeInvalidCharacter, CodeCommonPassword},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			result := Validate(test.password, test.policy)
+
+			assert.Equal(t, test.expectedValid, result.Valid)
+			assert.Equal(t, test.policy.Name, result.Policy)
+			var codes []string
+			for _, v := range result.Violations {
+				codes = append(codes, v.Code)
+			}
+			assert.Equal(t, test.expectedViolations, codes)
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	result := Validate("AbTp9!foA", Default())
+
+	assert.Equal(t, "Must not contain repeated characters (excluding spaces)", result.Violations[0].Message)
+	assert.Equal(t, "Não deve conter caracteres repetidos (sem contar espaços)", Message(PortugueseBR, result.Violations[0]))
+	assert.Equal(t, result.Violations[0].Message, Message("fr", result.Violations[0]))
+}
+
+func TestParse(t *testing.T) {
+	document, err := Parse([]byte(`{"default":"strict","policies":[{"name":"strict","minLength":12,"requireDigit":true}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "strict", document.Default)
+
+	_, err = Parse([]byte(`{"policies":[{"name":"strict","maxAge":90}]}`))
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(Policy{Name: "special", MinLength: 9, RequireSpecial: true, Whitespace: "strip"})
+	assert.NoError(t, err)
+	assert.Equal(t, Default().SpecialChars, p.SpecialChars)
+	assert.Empty(t, p.Whitespace)
+
+	_, err = New(Policy{Name: "invalid", MinLength: 12, MaxLength: 8})
+	assert.Error(t, err)
+}
+
+func TestEstimateStrength(t *testing.T) {
+	assert.Equal(t, VeryWeak, EstimateStrength("aaaa").Label)
+	assert.Equal(t, VeryStrong, EstimateStrength("xK#9mP!2qR$7vL@4nW&8").Label)
+}
+
+func TestGenerate(t *testing.T) {
+	pw, err := Generate(Default(), 0)
+	assert.NoError(t, err)
+	assert.Len(t, pw, DefaultGeneratedLength)
+	assert.True(t, Validate(pw, Default()).Valid)
+
+	_, err = Generate(Default(), MaxGeneratedLength+1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package passwordpolicy
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// vectors.json is shared with the WebAssembly harness in cmd/passwordwasm, so both
+// builds are held to the same expected results.
+type testVectors struct {
+	Policies json.RawMessage `json:"policies"`
+	Vectors  []struct {
+		Password   string   `json:"password"`
+		Policy     string   `json:"policy"`
+		IsValid    bool     `json:"isValid"`
+		Violations []string `json:"violations"`
+		Satisfied  []string `json:"satisfied"`
+		Strength   string   `json:"strength"`
+	} `json:"vectors"`
+}
+
+func TestVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/vectors.json")
+	assert.NoError(t, err)
+	var vectors testVectors
+	assert.NoError(t, json.Unmarshal(data, &vectors))
+	document, err := Parse(vectors.Policies)
+	assert.NoError(t, err)
+
+	for _, v := range vectors.Vectors {
+		t.Run(v.Password, func(t *testing.T) {
+			p, err := document.Find(v.Policy)
+			assert.NoError(t, err)
+
+			result := Validate(v.Password, p)
+
+			codes := []string{}
+			for _, violation := range result.Violations {
+				codes = append(codes, violation.Code)
+			}
+			satisfied := append([]string{}, result.Satisfied...)
+			assert.Equal(t, v.IsValid, result.Valid)
+			assert.Equal(t, v.Violations, codes)
+			assert.Equal(t, v.Satisfied, satisfied)
+			assert.Equal(t, v.Strength, EstimateStrength(v.Password).Label)
+		})
+	}
+}